@@ -17,6 +17,7 @@ import { useToast } from "@/hooks/use-toast";
 import { apiClient } from "@/services/ApiClient";
 import { useQueryClient } from "@tanstack/react-query";
 import { queryKeys } from "@/lib/queryKeys";
+import { validateExternalUrl } from "@/lib/urlValidation";
 import type { TeamLink, UserLink } from "@/types/api";
 
 interface AddLinkDialogProps {
@@ -47,15 +48,6 @@ interface FormErrors {
   category?: string;
 }
 
-const validateUrl = (url: string): boolean => {
-  try {
-    new URL(url);
-    return true;
-  } catch {
-    return false;
-  }
-};
-
 /**
  * Check if a link with the same name and category already exists
  */
@@ -118,10 +110,12 @@ export function AddLinkDialog({ open, onOpenChange, ownerId, onTeamLinkAdded }:
           }
         }
         return undefined;
-      case "url":
+      case "url": {
         if (!value.trim()) return "URL is required";
-        if (!validateUrl(value.trim())) return "Please enter a valid URL";
+        const urlResult = validateExternalUrl(value.trim());
+        if (!urlResult.valid) return urlResult.reason ?? "Please enter a valid URL";
         return undefined;
+      }
       case "category":
         if (!value) return "Category is required";
         
@@ -247,7 +241,7 @@ export function AddLinkDialog({ open, onOpenChange, ownerId, onTeamLinkAdded }:
     return (
       formData.name.trim().length >= 2 &&
       formData.url.trim().length > 0 &&
-      validateUrl(formData.url.trim()) &&
+      validateExternalUrl(formData.url.trim()).valid &&
       formData.category !== "" &&
       !checkDuplicateLink(currentUser.link, formData.name, formData.category)
     );
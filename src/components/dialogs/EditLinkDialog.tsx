@@ -15,6 +15,7 @@ import { useCategories } from "@/hooks/api/useLinks";
 import { useCurrentUser } from "@/hooks/api/useMembers";
 import { useToast } from "@/hooks/use-toast";
 import { useUpdateLink } from "@/hooks/api/mutations/useUpdateLink";
+import { validateExternalUrl } from "@/lib/urlValidation";
 import type { UserLink } from "@/types/api";
 
 interface EditLinkDialogProps {
@@ -37,15 +38,6 @@ interface FormErrors {
   category?: string;
 }
 
-const validateUrl = (url: string): boolean => {
-  try {
-    new URL(url);
-    return true;
-  } catch {
-    return false;
-  }
-};
-
 /**
  * Check if a link with the same name and category already exists
  * Excludes the current link being edited
@@ -118,10 +110,12 @@ export function EditLinkDialog({ open, onOpenChange, linkData }: EditLinkDialogP
         }
         return undefined;
 
-      case "url":
+      case "url": {
         if (!value.trim()) return "URL is required";
-        if (!validateUrl(value)) return "Please enter a valid URL";
+        const urlResult = validateExternalUrl(value.trim());
+        if (!urlResult.valid) return urlResult.reason ?? "Please enter a valid URL";
         return undefined;
+      }
 
       case "category":
         if (!value) return "Category is required";
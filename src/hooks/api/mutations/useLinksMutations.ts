@@ -13,6 +13,63 @@ async function deleteLink(linkId: string): Promise<void> {
   return apiClient.delete(`/links/${linkId}`);
 }
 
+/**
+ * One operation in a batch links request
+ */
+export interface LinkBatchOperation {
+  action: 'create' | 'update' | 'delete' | 'move_category';
+  id?: string; // Required for update/delete/move_category
+  data?: Record<string, unknown>; // Link fields for create/update
+  category_id?: string; // Target category for move_category
+}
+
+export interface LinkBatchItemResult {
+  index: number;
+  status: 'ok' | 'error';
+  id?: string;
+  error?: string;
+}
+
+export interface LinkBatchResponse {
+  results: LinkBatchItemResult[];
+}
+
+/**
+ * Apply many link operations in one transactional request
+ */
+async function batchLinks(operations: LinkBatchOperation[]): Promise<LinkBatchResponse> {
+  return apiClient.post<LinkBatchResponse>('/links:batch', { operations });
+}
+
+export interface MergeLinksRequest {
+  keep_id: string; // Surviving link; absorbs click stats and tags
+  merge_ids: string[]; // Duplicates to fold into it
+}
+
+/**
+ * Merge duplicate links into one, keeping click stats and tags
+ */
+async function mergeLinks(request: MergeLinksRequest): Promise<void> {
+  return apiClient.post<void>('/links/merge', request);
+}
+
+export interface CategoryCleanupRequest {
+  unused_months: number;
+  dry_run?: boolean; // Flag candidates without archiving them
+}
+
+export interface CategoryCleanupResponse {
+  archived: string[];
+  flagged: string[];
+}
+
+/**
+ * Archive (or just flag, with dry_run) categories unused for N months
+ */
+async function cleanupCategories(request: CategoryCleanupRequest): Promise<CategoryCleanupResponse> {
+  return apiClient.post<CategoryCleanupResponse>('/categories/cleanup', request);
+}
+
 // ============================================================================
 // MUTATION HOOKS
 // ============================================================================
@@ -44,7 +101,77 @@ export function useDeleteLink(
         options.onSuccess(data, linkId, context, undefined);
       }
     },
-    
+
+    ...options,
+  });
+}
+
+/**
+ * Hook to merge duplicate links into a surviving one
+ */
+export function useMergeLinks(
+  options?: UseMutationOptions<void, Error, MergeLinksRequest>
+) {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: mergeLinks,
+
+    onSuccess: async (data, request, context) => {
+      await queryClient.invalidateQueries({ queryKey: queryKeys.links.all });
+
+      if (options?.onSuccess) {
+        options.onSuccess(data, request, context, undefined);
+      }
+    },
+
+    ...options,
+  });
+}
+
+/**
+ * Hook to archive or flag unused categories (admin maintenance)
+ */
+export function useCleanupCategories(
+  options?: UseMutationOptions<CategoryCleanupResponse, Error, CategoryCleanupRequest>
+) {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: cleanupCategories,
+
+    onSuccess: async (data, request, context) => {
+      await queryClient.invalidateQueries({ queryKey: queryKeys.categories.all });
+
+      if (options?.onSuccess) {
+        options.onSuccess(data, request, context, undefined);
+      }
+    },
+
+    ...options,
+  });
+}
+
+/**
+ * Hook to apply batch link operations (multi-select actions in the bookmark manager)
+ */
+export function useBatchLinks(
+  options?: UseMutationOptions<LinkBatchResponse, Error, LinkBatchOperation[]>
+) {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: batchLinks,
+
+    onSuccess: async (data, operations, context) => {
+      await queryClient.invalidateQueries({ queryKey: queryKeys.links.all });
+      await queryClient.invalidateQueries({ queryKey: queryKeys.categories.all });
+
+      if (options?.onSuccess) {
+        options.onSuccess(data, operations, context, undefined);
+      }
+    },
+
     ...options,
   });
 }
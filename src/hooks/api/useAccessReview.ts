@@ -0,0 +1,14 @@
+import { useQuery, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import { fetchAccessReviewReport, AccessReviewReport } from '@/services/accessReviewApi';
+
+/**
+ * Hook to fetch the access review report (admin only)
+ */
+export function useAccessReviewReport(teamId?: string): UseQueryResult<AccessReviewReport, Error> {
+  return useQuery({
+    queryKey: queryKeys.accessReview.report(teamId),
+    queryFn: () => fetchAccessReviewReport(teamId),
+    staleTime: 10 * 60 * 1000, // Reports are expensive to generate
+  });
+}
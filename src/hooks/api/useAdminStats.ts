@@ -0,0 +1,31 @@
+import { useQuery, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchAdminStats,
+  fetchAdminSlos,
+  AdminStats,
+  AdminStatsWindow,
+  SlosResponse,
+} from '@/services/adminStatsApi';
+
+/**
+ * Hook to fetch aggregate portal stats for the admin dashboard
+ */
+export function useAdminStats(window: AdminStatsWindow = '7d'): UseQueryResult<AdminStats, Error> {
+  return useQuery({
+    queryKey: queryKeys.adminStats.window(window),
+    queryFn: () => fetchAdminStats(window),
+    staleTime: 5 * 60 * 1000, // Stats are recomputed periodically server-side
+  });
+}
+
+/**
+ * Hook to fetch per-route-group SLO compliance and burn rates
+ */
+export function useAdminSlos(window: AdminStatsWindow = '7d'): UseQueryResult<SlosResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.adminStats.slos(window),
+    queryFn: () => fetchAdminSlos(window),
+    refetchInterval: 60 * 1000, // Burn rates should surface quickly on the dashboard
+  });
+}
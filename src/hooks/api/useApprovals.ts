@@ -0,0 +1,75 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchApprovalRequests,
+  approveRequest,
+  rejectRequest,
+  withdrawRequest,
+  ApprovalRequest,
+  ApprovalRequestsResponse,
+  ApprovalStatus,
+} from '@/services/approvalsApi';
+
+/**
+ * Hook to list approval requests for the current user
+ */
+export function useApprovalRequests(
+  role: 'approver' | 'requester',
+  status?: ApprovalStatus
+): UseQueryResult<ApprovalRequestsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.approvals.list(role, status),
+    queryFn: () => fetchApprovalRequests(role, status),
+    refetchInterval: 60 * 1000, // Pending approvals should surface promptly
+  });
+}
+
+/**
+ * Hook for approving a pending request
+ */
+export function useApproveRequest(): UseMutationResult<
+  ApprovalRequest,
+  Error,
+  { requestId: string; comment?: string }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ requestId, comment }) => approveRequest(requestId, comment),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.approvals.all });
+    },
+  });
+}
+
+/**
+ * Hook for rejecting a pending request
+ */
+export function useRejectRequest(): UseMutationResult<
+  ApprovalRequest,
+  Error,
+  { requestId: string; comment?: string }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ requestId, comment }) => rejectRequest(requestId, comment),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.approvals.all });
+    },
+  });
+}
+
+/**
+ * Hook for withdrawing one's own pending request
+ */
+export function useWithdrawRequest(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: withdrawRequest,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.approvals.all });
+    },
+  });
+}
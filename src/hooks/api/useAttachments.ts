@@ -0,0 +1,88 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchAttachments,
+  createAttachment,
+  uploadToSignedUrl,
+  fetchAttachmentDownloadUrl,
+  deleteAttachment,
+  fetchStorageQuota,
+  Attachment,
+  AttachmentsResponse,
+  CreateAttachmentRequest,
+  StorageQuota,
+} from '@/services/attachmentsApi';
+
+/**
+ * Hook to list attachments, optionally filtered by context and team
+ */
+export function useAttachments(
+  context?: Attachment['context'],
+  teamId?: string
+): UseQueryResult<AttachmentsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.attachments.list(context, teamId),
+    queryFn: () => fetchAttachments(context, teamId),
+  });
+}
+
+/**
+ * Hook that requests a signed upload URL and pushes the file bytes to it
+ * in one step, returning the finished attachment
+ */
+export function useUploadAttachment(): UseMutationResult<
+  Attachment,
+  Error,
+  { request: CreateAttachmentRequest; file: Blob }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: async ({ request, file }) => {
+      const signed = await createAttachment(request);
+      await uploadToSignedUrl(signed.upload_url, signed.upload_headers, file);
+      return signed.attachment;
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.attachments.all });
+    },
+  });
+}
+
+/**
+ * Hook for resolving a short-lived signed download URL for an attachment
+ */
+export function useAttachmentDownloadUrl(): UseMutationResult<
+  { download_url: string; expires_at: string },
+  Error,
+  string
+> {
+  return useMutation({
+    mutationFn: (attachmentId: string) => fetchAttachmentDownloadUrl(attachmentId),
+  });
+}
+
+/**
+ * Hook for deleting an attachment
+ */
+export function useDeleteAttachment(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (attachmentId: string) => deleteAttachment(attachmentId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.attachments.all });
+    },
+  });
+}
+
+/**
+ * Hook to fetch a team's storage quota usage
+ */
+export function useStorageQuota(teamId: string): UseQueryResult<StorageQuota, Error> {
+  return useQuery({
+    queryKey: queryKeys.attachments.quota(teamId),
+    queryFn: () => fetchStorageQuota(teamId),
+    enabled: !!teamId,
+  });
+}
@@ -0,0 +1,33 @@
+import { useMutation, useQuery, UseMutationResult, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchTeamCalendar,
+  createICalFeed,
+  TeamCalendarResponse,
+  ICalFeedResponse,
+} from '@/services/calendarApi';
+
+/**
+ * Hook to fetch a team's on-call rotation and release calendar
+ */
+export function useTeamCalendar(
+  teamId: string,
+  from?: string,
+  to?: string
+): UseQueryResult<TeamCalendarResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.teams.calendar(teamId, from, to),
+    queryFn: () => fetchTeamCalendar(teamId, from, to),
+    enabled: !!teamId,
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+/**
+ * Hook for creating a signed iCal feed URL for a team's calendar
+ */
+export function useCreateICalFeed(): UseMutationResult<ICalFeedResponse, Error, string> {
+  return useMutation({
+    mutationFn: (teamId: string) => createICalFeed(teamId),
+  });
+}
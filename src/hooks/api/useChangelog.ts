@@ -0,0 +1,68 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchChangelog,
+  markChangelogSeen,
+  createChangelogEntry,
+  deleteChangelogEntry,
+  ChangelogEntry,
+  ChangelogResponse,
+  CreateChangelogEntryRequest,
+} from '@/services/changelogApi';
+
+/**
+ * Hook to fetch changelog entries for the what's-new popover
+ */
+export function useChangelog(limit?: number): UseQueryResult<ChangelogResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.changelog.list(limit),
+    queryFn: () => fetchChangelog(limit),
+    staleTime: 30 * 60 * 1000, // Entries are published rarely
+  });
+}
+
+/**
+ * Hook for marking all changelog entries as seen
+ */
+export function useMarkChangelogSeen(): UseMutationResult<void, Error, void> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: () => markChangelogSeen(),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.changelog.all });
+    },
+  });
+}
+
+/**
+ * Hook for creating a changelog entry (admin only)
+ */
+export function useCreateChangelogEntry(): UseMutationResult<
+  ChangelogEntry,
+  Error,
+  CreateChangelogEntryRequest
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: createChangelogEntry,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.changelog.all });
+    },
+  });
+}
+
+/**
+ * Hook for deleting a changelog entry (admin only)
+ */
+export function useDeleteChangelogEntry(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: deleteChangelogEntry,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.changelog.all });
+    },
+  });
+}
@@ -0,0 +1,31 @@
+import { useMutation, useQuery, UseMutationResult, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchCommands,
+  executeCommand,
+  CommandsResponse,
+  ExecuteCommandRequest,
+  ExecuteCommandResponse,
+} from '@/services/commandsApi';
+
+export function useCommands(): UseQueryResult<CommandsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.commands.all,
+    queryFn: fetchCommands,
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+interface ExecuteCommandVariables {
+  commandId: string;
+  request?: ExecuteCommandRequest;
+}
+
+/**
+ * Hook for executing a command palette action
+ */
+export function useExecuteCommand(): UseMutationResult<ExecuteCommandResponse, Error, ExecuteCommandVariables> {
+  return useMutation({
+    mutationFn: ({ commandId, request }: ExecuteCommandVariables) => executeCommand(commandId, request),
+  });
+}
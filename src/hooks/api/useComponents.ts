@@ -1,6 +1,6 @@
 import { useQuery, UseQueryOptions, UseQueryResult } from '@tanstack/react-query';
 import { queryKeys } from '@/lib/queryKeys';
-import { fetchComponentsByOrganization, fetchComponentsByTeamId, fetchComponentsByProject } from '@/services/ComponentsApi';
+import { fetchComponentsByOrganization, fetchComponentsByTeamId, fetchComponentsByProject, fetchCatalogGraph, CatalogGraphResponse } from '@/services/ComponentsApi';
 import type { ComponentListResponse, TeamComponentsListResponse } from '@/types/api';
 
 export function useComponentsByTeam(
@@ -60,3 +60,22 @@ export function useComponentsByProject(
     ...options,
   });
 }
+
+/**
+ * Hook to fetch the catalog dependency graph
+ */
+export function useCatalogGraph(
+  rootId?: string,
+  depth?: number,
+  options?: Omit<
+    UseQueryOptions<CatalogGraphResponse, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<CatalogGraphResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.components.graph(rootId, depth),
+    queryFn: () => fetchCatalogGraph(rootId, depth),
+    staleTime: 2 * 60 * 1000, // Cache for 2 minutes
+    ...options,
+  });
+}
@@ -0,0 +1,25 @@
+import { useQuery, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchCosts,
+  fetchCostAnomalies,
+  CostsParams,
+  CostsResponse,
+  CostAnomaliesResponse,
+} from '@/services/costsApi';
+
+export function useCosts(params?: CostsParams): UseQueryResult<CostsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.costs.list(params),
+    queryFn: () => fetchCosts(params),
+    staleTime: 60 * 60 * 1000, // Cost data refreshes daily at most
+  });
+}
+
+export function useCostAnomalies(params?: CostsParams): UseQueryResult<CostAnomaliesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.costs.anomalies(params),
+    queryFn: () => fetchCostAnomalies(params),
+    staleTime: 60 * 60 * 1000, // Cost data refreshes daily at most
+  });
+}
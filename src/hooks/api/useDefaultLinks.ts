@@ -0,0 +1,45 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchTeamDefaultLinks,
+  updateTeamDefaultLinks,
+  setDefaultLinksOptOut,
+  DefaultLinkEntry,
+  TeamDefaultLinks,
+} from '@/services/defaultLinksApi';
+
+/**
+ * Hook to fetch a team's default link set
+ */
+export function useTeamDefaultLinks(teamId: string): UseQueryResult<TeamDefaultLinks, Error> {
+  return useQuery({
+    queryKey: queryKeys.teams.defaultLinks(teamId),
+    queryFn: () => fetchTeamDefaultLinks(teamId),
+    enabled: !!teamId,
+  });
+}
+
+/**
+ * Hook for replacing a team's default link set (team leads only)
+ */
+export function useUpdateTeamDefaultLinks(
+  teamId: string
+): UseMutationResult<TeamDefaultLinks, Error, DefaultLinkEntry[]> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (links: DefaultLinkEntry[]) => updateTeamDefaultLinks(teamId, links),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.teams.defaultLinks(teamId) });
+    },
+  });
+}
+
+/**
+ * Hook for toggling the current user's default link provisioning opt-out
+ */
+export function useSetDefaultLinksOptOut(): UseMutationResult<void, Error, boolean> {
+  return useMutation({
+    mutationFn: (optOut: boolean) => setDefaultLinksOptOut(optOut),
+  });
+}
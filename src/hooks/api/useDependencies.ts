@@ -0,0 +1,81 @@
+import { useQuery, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  searchDependencies,
+  fetchLicenseReport,
+  fetchSbomVersions,
+  fetchSbomDiff,
+  fetchComponentVulnerabilities,
+  DependencySearchParams,
+  DependencySearchResponse,
+  LicenseReportResponse,
+  SbomVersionsResponse,
+  SbomDiff,
+  ComponentVulnerabilitiesResponse,
+  VulnerabilitySeverity,
+} from '@/services/dependenciesApi';
+
+/**
+ * Hook to search the org-wide dependency inventory
+ */
+export function useDependencySearch(
+  params?: DependencySearchParams
+): UseQueryResult<DependencySearchResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.dependencies.search(params),
+    queryFn: () => searchDependencies(params),
+    staleTime: 10 * 60 * 1000, // The inventory is refreshed by a scheduled job
+  });
+}
+
+/**
+ * Hook to fetch the org-wide license report
+ */
+export function useLicenseReport(): UseQueryResult<LicenseReportResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.dependencies.licenses(),
+    queryFn: fetchLicenseReport,
+    staleTime: 60 * 60 * 1000, // Regenerated daily
+  });
+}
+
+/**
+ * Hook to list stored SBOM versions for a component
+ */
+export function useSbomVersions(componentId: string): UseQueryResult<SbomVersionsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.dependencies.sboms(componentId),
+    queryFn: () => fetchSbomVersions(componentId),
+    enabled: !!componentId,
+  });
+}
+
+/**
+ * Hook to diff two SBOM versions of a component
+ */
+export function useSbomDiff(
+  componentId: string,
+  fromSbomId: string,
+  toSbomId: string
+): UseQueryResult<SbomDiff, Error> {
+  return useQuery({
+    queryKey: queryKeys.dependencies.sbomDiff(componentId, fromSbomId, toSbomId),
+    queryFn: () => fetchSbomDiff(componentId, fromSbomId, toSbomId),
+    enabled: !!componentId && !!fromSbomId && !!toSbomId,
+  });
+}
+
+/**
+ * Hook to fetch correlated vulnerabilities for a component
+ */
+export function useComponentVulnerabilities(
+  componentId: string,
+  minSeverity?: VulnerabilitySeverity
+): UseQueryResult<ComponentVulnerabilitiesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.dependencies.vulnerabilities(componentId, minSeverity),
+    queryFn: () => fetchComponentVulnerabilities(componentId, minSeverity),
+    enabled: !!componentId,
+    staleTime: 10 * 60 * 1000, // Correlation runs against advisory feeds on a schedule
+  });
+}
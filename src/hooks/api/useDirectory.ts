@@ -0,0 +1,91 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchDirectory,
+  fetchManagerChain,
+  fetchDirectoryActivity,
+  fetchMySkills,
+  updateMySkills,
+  fetchExperts,
+  DirectoryParams,
+  DirectoryResponse,
+  DirectoryUser,
+  DirectoryActivitySummary,
+  UserSkillsResponse,
+  ExpertsResponse,
+} from '@/services/directoryApi';
+
+/**
+ * Hook to browse the people directory
+ */
+export function useDirectory(params?: DirectoryParams): UseQueryResult<DirectoryResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.directory.list(params),
+    queryFn: () => fetchDirectory(params),
+    staleTime: 5 * 60 * 1000, // Directory data is cached server-side
+  });
+}
+
+/**
+ * Hook to fetch a user's manager chain
+ */
+export function useManagerChain(userId: string): UseQueryResult<{ chain: DirectoryUser[] }, Error> {
+  return useQuery({
+    queryKey: queryKeys.directory.managers(userId),
+    queryFn: () => fetchManagerChain(userId),
+    enabled: !!userId,
+    staleTime: 30 * 60 * 1000, // Org structure changes rarely
+  });
+}
+
+/**
+ * Hook to fetch a directory profile's activity summary
+ */
+export function useDirectoryActivity(userId: string): UseQueryResult<DirectoryActivitySummary, Error> {
+  return useQuery({
+    queryKey: queryKeys.directory.activity(userId),
+    queryFn: () => fetchDirectoryActivity(userId),
+    enabled: !!userId,
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook to fetch the current user's skill tags
+ */
+export function useMySkills(): UseQueryResult<UserSkillsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.directory.mySkills(),
+    queryFn: fetchMySkills,
+  });
+}
+
+/**
+ * Hook for updating declared skills and the expert-finder opt-out
+ */
+export function useUpdateMySkills(): UseMutationResult<
+  UserSkillsResponse,
+  Error,
+  { skills: string[]; expertFinderOptOut?: boolean }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ skills, expertFinderOptOut }) => updateMySkills(skills, expertFinderOptOut),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.directory.mySkills() });
+    },
+  });
+}
+
+/**
+ * Hook to find likely experts for a skill
+ */
+export function useExperts(skill: string): UseQueryResult<ExpertsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.directory.experts(skill),
+    queryFn: () => fetchExperts(skill),
+    enabled: !!skill,
+    staleTime: 10 * 60 * 1000, // Rankings are recomputed periodically, not live
+  });
+}
@@ -0,0 +1,61 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchDiscussions,
+  createDiscussion,
+  fetchTeamUnansweredDiscussions,
+  GitHubDiscussion,
+  GitHubDiscussionsResponse,
+  CreateDiscussionRequest,
+} from '@/services/githubApi';
+
+/**
+ * Hook to list discussions for a repository
+ */
+export function useDiscussions(
+  provider: string,
+  owner: string,
+  repo: string,
+  unansweredOnly?: boolean
+): UseQueryResult<GitHubDiscussionsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.discussions(provider, owner, repo, unansweredOnly),
+    queryFn: () => fetchDiscussions(provider, owner, repo, unansweredOnly),
+    enabled: !!provider && !!owner && !!repo,
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook to surface unanswered discussions across a team's repos
+ */
+export function useTeamUnansweredDiscussions(
+  teamId: string
+): UseQueryResult<GitHubDiscussionsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.unansweredDiscussions(teamId),
+    queryFn: () => fetchTeamUnansweredDiscussions(teamId),
+    enabled: !!teamId,
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook for creating a discussion
+ */
+export function useCreateDiscussion(): UseMutationResult<
+  GitHubDiscussion,
+  Error,
+  CreateDiscussionRequest
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: createDiscussion,
+    onSuccess: (_data, request) => {
+      queryClient.invalidateQueries({
+        queryKey: [...queryKeys.github.all, 'discussions', request.provider, request.owner, request.repo],
+      });
+    },
+  });
+}
@@ -0,0 +1,80 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchDraftWorkspace,
+  saveDraftFile,
+  discardDraftFile,
+  publishDrafts,
+  DraftFile,
+  DraftWorkspace,
+  PublishDraftsResponse,
+} from '@/services/draftsApi';
+
+/**
+ * Hook to fetch the current user's draft workspace for a repository
+ */
+export function useDraftWorkspace(
+  provider: string,
+  owner: string,
+  repo: string
+): UseQueryResult<DraftWorkspace, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.drafts(provider, owner, repo),
+    queryFn: () => fetchDraftWorkspace(provider, owner, repo),
+    enabled: !!provider && !!owner && !!repo,
+  });
+}
+
+/**
+ * Hook for autosaving a draft edit to one file
+ */
+export function useSaveDraftFile(
+  provider: string,
+  owner: string,
+  repo: string
+): UseMutationResult<DraftFile, Error, Omit<DraftFile, 'updated_at'>> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (file) => saveDraftFile(provider, owner, repo, file),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.drafts(provider, owner, repo) });
+    },
+  });
+}
+
+/**
+ * Hook for discarding the draft edit for one file
+ */
+export function useDiscardDraftFile(
+  provider: string,
+  owner: string,
+  repo: string
+): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (path: string) => discardDraftFile(provider, owner, repo, path),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.drafts(provider, owner, repo) });
+    },
+  });
+}
+
+/**
+ * Hook for publishing all drafts as a single branch + PR
+ */
+export function usePublishDrafts(
+  provider: string,
+  owner: string,
+  repo: string
+): UseMutationResult<PublishDraftsResponse, Error, { prTitle: string; prBody?: string }> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ prTitle, prBody }) => publishDrafts(provider, owner, repo, prTitle, prBody),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.drafts(provider, owner, repo) });
+    },
+  });
+}
@@ -0,0 +1,62 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchEditPresence,
+  acquireEditLock,
+  releaseEditLock,
+  EditLock,
+  EditPresenceResponse,
+} from '@/services/editLocksApi';
+
+/**
+ * Hook to poll locks and presence for a repository while the editor is open
+ */
+export function useEditPresence(
+  provider: string,
+  owner: string,
+  repo: string,
+  enabled = true
+): UseQueryResult<EditPresenceResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.editLocks(provider, owner, repo),
+    queryFn: () => fetchEditPresence(provider, owner, repo),
+    enabled: enabled && !!provider && !!owner && !!repo,
+    refetchInterval: 15 * 1000, // Presence should feel close to live
+  });
+}
+
+/**
+ * Hook for acquiring or refreshing an advisory lock on a file
+ */
+export function useAcquireEditLock(
+  provider: string,
+  owner: string,
+  repo: string
+): UseMutationResult<EditLock, Error, { path: string; force?: boolean }> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ path, force }) => acquireEditLock(provider, owner, repo, path, force),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.editLocks(provider, owner, repo) });
+    },
+  });
+}
+
+/**
+ * Hook for releasing an advisory lock on a file
+ */
+export function useReleaseEditLock(
+  provider: string,
+  owner: string,
+  repo: string
+): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (path: string) => releaseEditLock(provider, owner, repo, path),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.editLocks(provider, owner, repo) });
+    },
+  });
+}
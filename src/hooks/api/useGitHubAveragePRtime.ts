@@ -1,18 +1,20 @@
 import { useQuery, UseQueryOptions, UseQueryResult } from '@tanstack/react-query';
 import { queryKeys } from '@/lib/queryKeys';
-import { fetchGitHubAveragePRTime } from '@/services/githubApi';
+import { fetchGitHubAveragePRTime, MetricSeriesOptions } from '@/services/githubApi';
 import type { GitHubAveragePRTimeResponse } from '@/types/api';
 
 export function useGitHubAveragePRTime(
   period?: string,
+  teamId?: string,
+  seriesOptions?: MetricSeriesOptions,
   options?: Omit<
     UseQueryOptions<GitHubAveragePRTimeResponse, Error>,
     'queryKey' | 'queryFn'
   >
 ): UseQueryResult<GitHubAveragePRTimeResponse, Error> {
   return useQuery({
-    queryKey: queryKeys.github.averagePRTime(period),
-    queryFn: () => fetchGitHubAveragePRTime(period),
+    queryKey: queryKeys.github.averagePRTime(period, teamId, seriesOptions),
+    queryFn: () => fetchGitHubAveragePRTime(period, teamId, seriesOptions),
     staleTime: 5 * 60 * 1000, // Cache for 5 minutes
     ...options,
   });
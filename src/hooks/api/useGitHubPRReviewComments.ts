@@ -1,18 +1,20 @@
 import { useQuery, UseQueryOptions, UseQueryResult } from '@tanstack/react-query';
 import { queryKeys } from '@/lib/queryKeys';
-import { fetchGitHubPRReviewComments } from '@/services/githubApi';
+import { fetchGitHubPRReviewComments, MetricSeriesOptions } from '@/services/githubApi';
 import type { GitHubPRReviewCommentsResponse } from '@/types/api';
 
 export function useGitHubPRReviewComments(
   period?: string,
+  teamId?: string,
+  seriesOptions?: MetricSeriesOptions,
   options?: Omit<
     UseQueryOptions<GitHubPRReviewCommentsResponse, Error>,
     'queryKey' | 'queryFn'
   >
 ): UseQueryResult<GitHubPRReviewCommentsResponse, Error> {
   return useQuery({
-    queryKey: queryKeys.github.prReviewComments(period),
-    queryFn: () => fetchGitHubPRReviewComments(period),
+    queryKey: queryKeys.github.prReviewComments(period, teamId, seriesOptions),
+    queryFn: () => fetchGitHubPRReviewComments(period, teamId, seriesOptions),
     staleTime: 5 * 60 * 1000, // Cache for 5 minutes
     ...options,
   });
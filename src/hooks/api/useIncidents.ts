@@ -0,0 +1,113 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchIncidents,
+  fetchIncident,
+  createIncident,
+  updateIncident,
+  addIncidentTimelineEntry,
+  fetchPostmortem,
+  upsertPostmortem,
+  Incident,
+  IncidentsParams,
+  IncidentsResponse,
+  CreateIncidentRequest,
+  UpdateIncidentRequest,
+  Postmortem,
+} from '@/services/incidentsApi';
+
+export function useIncidents(params?: IncidentsParams): UseQueryResult<IncidentsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.incidents.list(params),
+    queryFn: () => fetchIncidents(params),
+    staleTime: 60 * 1000, // Incidents move fast
+  });
+}
+
+export function useIncident(incidentId: string): UseQueryResult<Incident, Error> {
+  return useQuery({
+    queryKey: queryKeys.incidents.detail(incidentId),
+    queryFn: () => fetchIncident(incidentId),
+    enabled: !!incidentId,
+  });
+}
+
+export function usePostmortem(incidentId: string): UseQueryResult<Postmortem, Error> {
+  return useQuery({
+    queryKey: queryKeys.incidents.postmortem(incidentId),
+    queryFn: () => fetchPostmortem(incidentId),
+    enabled: !!incidentId,
+  });
+}
+
+/**
+ * Hook for recording a new incident
+ */
+export function useCreateIncident(): UseMutationResult<Incident, Error, CreateIncidentRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: CreateIncidentRequest) => createIncident(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.incidents.lists() });
+    },
+  });
+}
+
+interface UpdateIncidentVariables {
+  incidentId: string;
+  request: UpdateIncidentRequest;
+}
+
+/**
+ * Hook for updating an incident
+ */
+export function useUpdateIncident(): UseMutationResult<Incident, Error, UpdateIncidentVariables> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ incidentId, request }: UpdateIncidentVariables) => updateIncident(incidentId, request),
+    onSuccess: (_data, variables) => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.incidents.detail(variables.incidentId) });
+      queryClient.invalidateQueries({ queryKey: queryKeys.incidents.lists() });
+    },
+  });
+}
+
+interface TimelineEntryVariables {
+  incidentId: string;
+  text: string;
+}
+
+/**
+ * Hook for appending an incident timeline entry
+ */
+export function useAddIncidentTimelineEntry(): UseMutationResult<Incident, Error, TimelineEntryVariables> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ incidentId, text }: TimelineEntryVariables) => addIncidentTimelineEntry(incidentId, text),
+    onSuccess: (_data, variables) => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.incidents.detail(variables.incidentId) });
+    },
+  });
+}
+
+interface UpsertPostmortemVariables {
+  incidentId: string;
+  postmortem: Parameters<typeof upsertPostmortem>[1];
+}
+
+/**
+ * Hook for creating or replacing an incident's postmortem
+ */
+export function useUpsertPostmortem(): UseMutationResult<Postmortem, Error, UpsertPostmortemVariables> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ incidentId, postmortem }: UpsertPostmortemVariables) => upsertPostmortem(incidentId, postmortem),
+    onSuccess: (_data, variables) => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.incidents.postmortem(variables.incidentId) });
+    },
+  });
+}
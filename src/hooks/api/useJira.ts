@@ -8,7 +8,15 @@ import type {
   MyJiraIssuesParams,
   MyJiraIssuesCountParams,
 } from '@/types/api';
-import { fetchJiraIssues, fetchMyJiraIssues, fetchMyJiraIssuesCount } from '@/services/JiraApi';
+import {
+  fetchJiraIssues,
+  fetchMyJiraIssues,
+  fetchMyJiraIssuesCount,
+  fetchTeamSprintRollup,
+  fetchTeamEpics,
+  TeamSprintRollup,
+  TeamEpicsResponse,
+} from '@/services/JiraApi';
 
 // ============================================================================
 // REACT HOOKS
@@ -65,3 +73,41 @@ export function useMyJiraIssuesCount(
     ...options,
   });
 }
+
+/**
+ * Hook to fetch the active sprint burndown for a team
+ */
+export function useTeamSprintRollup(
+  team: string,
+  options?: Omit<
+    UseQueryOptions<TeamSprintRollup, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<TeamSprintRollup, Error> {
+  return useQuery({
+    queryKey: queryKeys.jira.teamSprint(team),
+    queryFn: () => fetchTeamSprintRollup(team),
+    enabled: !!team,
+    staleTime: 5 * 60 * 1000, // Rollups are cached server-side anyway
+    ...options,
+  });
+}
+
+/**
+ * Hook to fetch epic progress for a team
+ */
+export function useTeamEpics(
+  team: string,
+  options?: Omit<
+    UseQueryOptions<TeamEpicsResponse, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<TeamEpicsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.jira.teamEpics(team),
+    queryFn: () => fetchTeamEpics(team),
+    enabled: !!team,
+    staleTime: 5 * 60 * 1000, // Rollups are cached server-side anyway
+    ...options,
+  });
+}
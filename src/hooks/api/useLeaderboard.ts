@@ -0,0 +1,47 @@
+import { useQuery, useMutation, useQueryClient, UseQueryOptions, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchLeaderboard,
+  fetchLeaderboardOptOut,
+  updateLeaderboardOptOut,
+  LeaderboardParams,
+  LeaderboardResponse,
+  LeaderboardOptOutResponse,
+} from '@/services/leaderboardApi';
+
+export function useLeaderboard(
+  params?: LeaderboardParams,
+  options?: Omit<
+    UseQueryOptions<LeaderboardResponse, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<LeaderboardResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.leaderboard.list(params),
+    queryFn: () => fetchLeaderboard(params),
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+    ...options,
+  });
+}
+
+export function useLeaderboardOptOut(): UseQueryResult<LeaderboardOptOutResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.leaderboard.optOut(),
+    queryFn: fetchLeaderboardOptOut,
+  });
+}
+
+/**
+ * Hook for toggling the current user's leaderboard opt-out
+ */
+export function useUpdateLeaderboardOptOut(): UseMutationResult<LeaderboardOptOutResponse, Error, boolean> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (optedOut: boolean) => updateLeaderboardOptOut(optedOut),
+    onSuccess: () => {
+      // Opting out changes which rows the server returns
+      queryClient.invalidateQueries({ queryKey: queryKeys.leaderboard.all });
+    },
+  });
+}
@@ -2,6 +2,7 @@ import { useQuery, UseQueryOptions, UseQueryResult } from '@tanstack/react-query
 import { apiClient } from '@/services/ApiClient';
 import { queryKeys } from '@/lib/queryKeys';
 import type { LinksApiResponse, CategoriesApiResponse } from '@/types/api';
+import { buildFilterParams, QueryFilter, QuerySort } from '@/lib/queryFilter';
 
 /**
  * Links Query Hooks
@@ -13,9 +14,19 @@ import type { LinksApiResponse, CategoriesApiResponse } from '@/types/api';
 
 /**
  * Fetch all links
+ *
+ * @param fields - Optional comma-separated field list for partial responses
+ * @param filters - Optional structured filters (see lib/queryFilter)
+ * @param sorts - Optional sort order
  */
-async function fetchLinks(): Promise<LinksApiResponse> {
-  return apiClient.get<LinksApiResponse>('/links');
+async function fetchLinks(
+  fields?: string,
+  filters?: QueryFilter[],
+  sorts?: QuerySort[]
+): Promise<LinksApiResponse> {
+  return apiClient.get<LinksApiResponse>('/links', {
+    params: { fields, ...buildFilterParams(filters, sorts) },
+  });
 }
 
 /**
@@ -25,22 +36,85 @@ async function fetchCategories(): Promise<CategoriesApiResponse> {
   return apiClient.get<CategoriesApiResponse>('/categories');
 }
 
+/**
+ * Per-category usage statistics
+ */
+export interface CategoryStats {
+  category_id: string;
+  category_name: string;
+  owner?: string;
+  team_id?: string;
+  link_count: number;
+  last_link_added_at?: string;
+}
+
+export interface CategoryStatsResponse {
+  stats: CategoryStats[];
+}
+
+/**
+ * Fetch link counts per category per owner/team
+ */
+async function fetchCategoryStats(): Promise<CategoryStatsResponse> {
+  return apiClient.get<CategoryStatsResponse>('/categories/stats');
+}
+
+/**
+ * A group of links whose normalized URLs match
+ */
+export interface DuplicateLinkGroup {
+  normalized_url: string;
+  link_ids: string[];
+}
+
+export interface DuplicateLinksResponse {
+  groups: DuplicateLinkGroup[];
+}
+
+/**
+ * Detect duplicate/near-duplicate URLs in the user's or a team's links
+ */
+async function fetchDuplicateLinks(teamId?: string): Promise<DuplicateLinksResponse> {
+  return apiClient.get<DuplicateLinksResponse>('/links/duplicates', {
+    params: { team_id: teamId },
+  });
+}
+
 // ============================================================================
 // REACT HOOKS
 // ============================================================================
 
+/**
+ * Optional filtering and sorting for useLinks (see lib/queryFilter)
+ */
+export interface UseLinksParams {
+  fields?: string;
+  filters?: QueryFilter[];
+  sorts?: QuerySort[];
+}
+
 /**
  * Hook to fetch all links
+ *
+ * Filters and sorts are serialized into the query key so each filtered view
+ * is cached independently of the unfiltered list.
  */
 export function useLinks(
+  params?: UseLinksParams,
   options?: Omit<
     UseQueryOptions<LinksApiResponse, Error>,
     'queryKey' | 'queryFn'
   >
 ): UseQueryResult<LinksApiResponse, Error> {
+  const { fields, filters, sorts } = params ?? {};
+  const { filter, sort } = buildFilterParams(filters, sorts);
+
   return useQuery({
-    queryKey: queryKeys.links.all,
-    queryFn: fetchLinks,
+    queryKey:
+      filter || sort || fields
+        ? queryKeys.links.filtered(filter, sort, fields)
+        : queryKeys.links.all,
+    queryFn: () => fetchLinks(fields, filters, sorts),
     staleTime: 5 * 60 * 1000, // Cache for 5 minutes
     ...options,
   });
@@ -61,4 +135,39 @@ export function useCategories(
     staleTime: 10 * 60 * 1000, // Cache for 10 minutes (categories change less frequently)
     ...options,
   });
+}
+
+/**
+ * Hook to detect duplicate links in the user's or a team's collection
+ */
+export function useDuplicateLinks(
+  teamId?: string,
+  options?: Omit<
+    UseQueryOptions<DuplicateLinksResponse, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<DuplicateLinksResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.links.duplicates(teamId),
+    queryFn: () => fetchDuplicateLinks(teamId),
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+    ...options,
+  });
+}
+
+/**
+ * Hook to fetch category usage statistics (admin visibility into category sprawl)
+ */
+export function useCategoryStats(
+  options?: Omit<
+    UseQueryOptions<CategoryStatsResponse, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<CategoryStatsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.categories.stats(),
+    queryFn: fetchCategoryStats,
+    staleTime: 10 * 60 * 1000, // Cache for 10 minutes (categories change less frequently)
+    ...options,
+  });
 }
\ No newline at end of file
@@ -0,0 +1,33 @@
+// src/hooks/api/useMergePullRequest.ts
+
+import { useMutation, useQueryClient } from '@tanstack/react-query';
+import { mergePullRequest } from '@/services/githubApi';
+import { useToast } from '@/hooks/use-toast';
+import { MergePullRequestParams } from '@/types/developer-portal';
+
+export function useMergePullRequest() {
+  const queryClient = useQueryClient();
+  const { toast } = useToast();
+
+  return useMutation({
+    mutationFn: (params: MergePullRequestParams) => mergePullRequest(params),
+    onSuccess: (data, variables) => {
+      // Invalidate PR queries to refresh the list
+      queryClient.invalidateQueries({ queryKey: ['github', 'pull-requests'] });
+
+      toast({
+        title: 'Pull Request Merged',
+        description: variables.delete_branch
+          ? `PR #${variables.prNumber} merged (${variables.merge_method}) and branch deleted successfully.`
+          : `PR #${variables.prNumber} merged (${variables.merge_method}) successfully.`,
+      });
+    },
+    onError: (error: Error, variables) => {
+      toast({
+        title: 'Failed to Merge PR',
+        description: `Could not merge PR #${variables.prNumber}: ${error.message}`,
+        variant: 'destructive',
+      });
+    },
+  });
+}
@@ -0,0 +1,14 @@
+import { useQuery, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import { fetchMetricAnomalies, MetricAnomaliesResponse } from '@/services/githubApi';
+
+/**
+ * Hook to fetch recently flagged metric anomalies
+ */
+export function useMetricAnomalies(teamId?: string): UseQueryResult<MetricAnomaliesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.metricAnomalies(teamId),
+    queryFn: () => fetchMetricAnomalies(teamId),
+    staleTime: 5 * 60 * 1000, // The detector runs on a schedule, not live
+  });
+}
@@ -0,0 +1,40 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchTeamMetricGoals,
+  updateTeamMetricGoals,
+  MetricGoal,
+  TeamMetricGoalsResponse,
+} from '@/services/metricGoalsApi';
+
+/**
+ * Hook to fetch a team's metric goals with attainment status
+ */
+export function useTeamMetricGoals(teamId: string): UseQueryResult<TeamMetricGoalsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.teams.metricGoals(teamId),
+    queryFn: () => fetchTeamMetricGoals(teamId),
+    enabled: !!teamId,
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook for replacing a team's metric goals (team leads only)
+ */
+export function useUpdateTeamMetricGoals(
+  teamId: string
+): UseMutationResult<
+  TeamMetricGoalsResponse,
+  Error,
+  Pick<MetricGoal, 'metric' | 'target_value' | 'comparison'>[]
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (goals) => updateTeamMetricGoals(teamId, goals),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.teams.metricGoals(teamId) });
+    },
+  });
+}
@@ -0,0 +1,85 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchNotificationRules,
+  createNotificationRule,
+  updateNotificationRule,
+  deleteNotificationRule,
+  testFireNotificationRule,
+  NotificationRule,
+  NotificationRulesResponse,
+  UpsertNotificationRuleRequest,
+} from '@/services/notificationRulesApi';
+
+/**
+ * Hook to list notification rules for the current user and their teams
+ */
+export function useNotificationRules(): UseQueryResult<NotificationRulesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.notificationRules.all,
+    queryFn: fetchNotificationRules,
+  });
+}
+
+/**
+ * Hook for creating a notification rule
+ */
+export function useCreateNotificationRule(): UseMutationResult<
+  NotificationRule,
+  Error,
+  UpsertNotificationRuleRequest
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: createNotificationRule,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.notificationRules.all });
+    },
+  });
+}
+
+/**
+ * Hook for updating a notification rule
+ */
+export function useUpdateNotificationRule(): UseMutationResult<
+  NotificationRule,
+  Error,
+  { ruleId: string; request: UpsertNotificationRuleRequest }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ ruleId, request }) => updateNotificationRule(ruleId, request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.notificationRules.all });
+    },
+  });
+}
+
+/**
+ * Hook for deleting a notification rule
+ */
+export function useDeleteNotificationRule(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: deleteNotificationRule,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.notificationRules.all });
+    },
+  });
+}
+
+/**
+ * Hook for test-firing a rule against a sample event
+ */
+export function useTestFireNotificationRule(): UseMutationResult<
+  { matched: boolean; rendered_message: string },
+  Error,
+  string
+> {
+  return useMutation({
+    mutationFn: testFireNotificationRule,
+  });
+}
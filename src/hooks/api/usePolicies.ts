@@ -0,0 +1,80 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchPolicies,
+  createPolicy,
+  updatePolicy,
+  deletePolicy,
+  fetchPolicyDecisions,
+  Policy,
+  PoliciesResponse,
+  UpsertPolicyRequest,
+  PolicyDecisionsResponse,
+} from '@/services/policiesApi';
+
+/**
+ * Hook to list org policies (admin only)
+ */
+export function usePolicies(): UseQueryResult<PoliciesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.policies.all,
+    queryFn: fetchPolicies,
+  });
+}
+
+/**
+ * Hook for creating a policy
+ */
+export function useCreatePolicy(): UseMutationResult<Policy, Error, UpsertPolicyRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: createPolicy,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.policies.all });
+    },
+  });
+}
+
+/**
+ * Hook for updating a policy
+ */
+export function useUpdatePolicy(): UseMutationResult<
+  Policy,
+  Error,
+  { policyId: string; request: UpsertPolicyRequest }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ policyId, request }) => updatePolicy(policyId, request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.policies.all });
+    },
+  });
+}
+
+/**
+ * Hook for deleting a policy
+ */
+export function useDeletePolicy(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: deletePolicy,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.policies.all });
+    },
+  });
+}
+
+/**
+ * Hook to fetch recent policy decisions for a policy
+ */
+export function usePolicyDecisions(policyId: string): UseQueryResult<PolicyDecisionsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.policies.decisions(policyId),
+    queryFn: () => fetchPolicyDecisions(policyId),
+    enabled: !!policyId,
+  });
+}
@@ -0,0 +1,82 @@
+import { useQuery, useMutation, useQueryClient, UseQueryOptions, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchUserPreferences,
+  fetchUserPreferenceNamespace,
+  updateUserPreferences,
+  fetchWorkingHours,
+  updateWorkingHours,
+  PreferenceNamespace,
+  PreferenceValues,
+  UserPreferencesResponse,
+  WorkingHours,
+} from '@/services/preferencesApi';
+
+export function useUserPreferences(
+  options?: Omit<
+    UseQueryOptions<UserPreferencesResponse, Error>,
+    'queryKey' | 'queryFn'
+  >
+): UseQueryResult<UserPreferencesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.preferences.all,
+    queryFn: fetchUserPreferences,
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+    ...options,
+  });
+}
+
+export function usePreferenceNamespace(
+  namespace: PreferenceNamespace
+): UseQueryResult<PreferenceValues, Error> {
+  return useQuery({
+    queryKey: queryKeys.preferences.namespace(namespace),
+    queryFn: () => fetchUserPreferenceNamespace(namespace),
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+interface UpdatePreferencesVariables {
+  namespace: PreferenceNamespace;
+  values: PreferenceValues;
+}
+
+/**
+ * Hook for replacing a preference namespace (dashboard layout, theme, etc.)
+ */
+export function useUpdatePreferences(): UseMutationResult<PreferenceValues, Error, UpdatePreferencesVariables> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ namespace, values }: UpdatePreferencesVariables) => updateUserPreferences(namespace, values),
+    onSuccess: (_data, variables) => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.preferences.namespace(variables.namespace) });
+      queryClient.invalidateQueries({ queryKey: queryKeys.preferences.all, exact: true });
+    },
+  });
+}
+
+/**
+ * Hook to fetch the current user's working hours configuration
+ */
+export function useWorkingHours(): UseQueryResult<WorkingHours, Error> {
+  return useQuery({
+    queryKey: queryKeys.preferences.workingHours(),
+    queryFn: fetchWorkingHours,
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+/**
+ * Hook for replacing the current user's working hours configuration
+ */
+export function useUpdateWorkingHours(): UseMutationResult<WorkingHours, Error, WorkingHours> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: updateWorkingHours,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.preferences.workingHours() });
+    },
+  });
+}
@@ -0,0 +1,54 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchMyProjectItems,
+  fetchTeamProjectItems,
+  moveProjectItem,
+  ProjectBoardItem,
+  ProjectBoardItemsResponse,
+} from '@/services/projectBoardsApi';
+
+/**
+ * Hook to fetch Projects v2 items assigned to the current user
+ */
+export function useMyProjectItems(provider: string): UseQueryResult<ProjectBoardItemsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.myProjectItems(provider),
+    queryFn: () => fetchMyProjectItems(provider),
+    enabled: !!provider,
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook to fetch Projects v2 items for a team
+ */
+export function useTeamProjectItems(
+  provider: string,
+  teamId: string
+): UseQueryResult<ProjectBoardItemsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.teamProjectItems(provider, teamId),
+    queryFn: () => fetchTeamProjectItems(provider, teamId),
+    enabled: !!provider && !!teamId,
+    staleTime: 5 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook for moving a project item between status columns
+ */
+export function useMoveProjectItem(
+  provider: string
+): UseMutationResult<ProjectBoardItem, Error, { itemId: string; status: string }> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ itemId, status }) => moveProjectItem(provider, itemId, status),
+    onSuccess: () => {
+      queryClient.invalidateQueries({
+        queryKey: [...queryKeys.github.all, 'project-items', provider],
+      });
+    },
+  });
+}
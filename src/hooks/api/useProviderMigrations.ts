@@ -0,0 +1,42 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchProviderMigrations,
+  startProviderMigration,
+  ProviderMigration,
+  ProviderMigrationsResponse,
+  StartProviderMigrationRequest,
+} from '@/services/providerMigrationApi';
+
+/**
+ * Hook to list provider migrations; polls while one is in flight
+ */
+export function useProviderMigrations(): UseQueryResult<ProviderMigrationsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.providerMigrations.all,
+    queryFn: fetchProviderMigrations,
+    refetchInterval: (query) => {
+      const migrations = query.state.data?.migrations;
+      const active = migrations?.some((m) => m.phase === 'dual_read' || m.phase === 'cutover');
+      return active ? 30 * 1000 : false;
+    },
+  });
+}
+
+/**
+ * Hook for starting a provider migration (admin only)
+ */
+export function useStartProviderMigration(): UseMutationResult<
+  ProviderMigration,
+  Error,
+  StartProviderMigrationRequest
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: startProviderMigration,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.providerMigrations.all });
+    },
+  });
+}
@@ -0,0 +1,83 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchRecentResources,
+  recordResourceVisit,
+  fetchFavorites,
+  addFavorite,
+  removeFavorite,
+  PortalResourceKind,
+  PortalResourceRef,
+  RecentResourcesResponse,
+  FavoritesResponse,
+} from '@/services/recentApi';
+
+/**
+ * Hook to fetch recently accessed resources for the quick-access widget
+ */
+export function useRecentResources(limit?: number): UseQueryResult<RecentResourcesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.recent.list(limit),
+    queryFn: () => fetchRecentResources(limit),
+    staleTime: 60 * 1000,
+  });
+}
+
+/**
+ * Hook for recording a resource visit
+ */
+export function useRecordResourceVisit(): UseMutationResult<
+  void,
+  Error,
+  Omit<PortalResourceRef, 'visited_at'>
+> {
+  return useMutation({
+    mutationFn: recordResourceVisit,
+  });
+}
+
+/**
+ * Hook to fetch the current user's favorites
+ */
+export function useFavorites(): UseQueryResult<FavoritesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.favorites.all,
+    queryFn: fetchFavorites,
+  });
+}
+
+/**
+ * Hook for pinning a resource to favorites
+ */
+export function useAddFavorite(): UseMutationResult<
+  void,
+  Error,
+  Omit<PortalResourceRef, 'visited_at'>
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: addFavorite,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.favorites.all });
+    },
+  });
+}
+
+/**
+ * Hook for removing a resource from favorites
+ */
+export function useRemoveFavorite(): UseMutationResult<
+  void,
+  Error,
+  { kind: PortalResourceKind; resourceId: string }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ kind, resourceId }) => removeFavorite(kind, resourceId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.favorites.all });
+    },
+  });
+}
@@ -0,0 +1,48 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchReminders,
+  snoozeItem,
+  cancelReminder,
+  Reminder,
+  RemindersResponse,
+  SnoozeRequest,
+} from '@/services/remindersApi';
+
+/**
+ * Hook to list the current user's pending reminders
+ */
+export function useReminders(): UseQueryResult<RemindersResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.reminders.all,
+    queryFn: fetchReminders,
+  });
+}
+
+/**
+ * Hook for snoozing a PR, notification, or review request
+ */
+export function useSnoozeItem(): UseMutationResult<Reminder, Error, SnoozeRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: snoozeItem,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.reminders.all });
+    },
+  });
+}
+
+/**
+ * Hook for cancelling a pending reminder
+ */
+export function useCancelReminder(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: cancelReminder,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.reminders.all });
+    },
+  });
+}
@@ -0,0 +1,19 @@
+import { useQuery, UseQueryResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import { fetchRepoSettingsAudit, RepoSettingsAuditResponse } from '@/services/githubApi';
+
+/**
+ * Hook to audit a repository's settings against org policy
+ */
+export function useRepoSettingsAudit(
+  provider: string,
+  owner: string,
+  repo: string
+): UseQueryResult<RepoSettingsAuditResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.settingsAudit(provider, owner, repo),
+    queryFn: () => fetchRepoSettingsAudit(provider, owner, repo),
+    enabled: !!provider && !!owner && !!repo,
+    staleTime: 10 * 60 * 1000, // Audits are recomputed on a schedule
+  });
+}
@@ -0,0 +1,80 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchRetentionPolicies,
+  updateRetentionPolicy,
+  runRetentionPurge,
+  fetchArchives,
+  restoreArchive,
+  RetentionDataType,
+  RetentionPolicy,
+  RetentionPoliciesResponse,
+  PurgeReport,
+  ArchivesResponse,
+} from '@/services/retentionApi';
+
+/**
+ * Hook to fetch retention policies (admin only)
+ */
+export function useRetentionPolicies(): UseQueryResult<RetentionPoliciesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.retention.all,
+    queryFn: fetchRetentionPolicies,
+  });
+}
+
+/**
+ * Hook for updating a retention policy
+ */
+export function useUpdateRetentionPolicy(): UseMutationResult<
+  RetentionPolicy,
+  Error,
+  { dataType: RetentionDataType; retentionDays: number; enabled: boolean }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ dataType, retentionDays, enabled }) =>
+      updateRetentionPolicy(dataType, retentionDays, enabled),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.retention.all });
+    },
+  });
+}
+
+/**
+ * Hook for triggering a purge run (dry run by default)
+ */
+export function useRunRetentionPurge(): UseMutationResult<
+  PurgeReport,
+  Error,
+  { dataType: RetentionDataType; dryRun?: boolean }
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ dataType, dryRun }) => runRetentionPurge(dataType, dryRun),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.retention.all });
+    },
+  });
+}
+
+/**
+ * Hook to list cold-storage archives (admin only)
+ */
+export function useArchives(dataType?: string): UseQueryResult<ArchivesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.retention.archives(dataType),
+    queryFn: () => fetchArchives(dataType),
+  });
+}
+
+/**
+ * Hook for restoring an archive into a staging table
+ */
+export function useRestoreArchive(): UseMutationResult<{ staging_table: string }, Error, string> {
+  return useMutation({
+    mutationFn: restoreArchive,
+  });
+}
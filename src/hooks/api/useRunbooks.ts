@@ -0,0 +1,71 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchRunbooks,
+  fetchRunbookContent,
+  registerRunbook,
+  markRunbookReviewed,
+  deleteRunbook,
+  Runbook,
+  RunbooksParams,
+  RunbooksResponse,
+  RegisterRunbookRequest,
+} from '@/services/runbooksApi';
+
+export function useRunbooks(params?: RunbooksParams): UseQueryResult<RunbooksResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.runbooks.list(params),
+    queryFn: () => fetchRunbooks(params),
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+export function useRunbookContent(runbookId: string): UseQueryResult<{ content: string }, Error> {
+  return useQuery({
+    queryKey: queryKeys.runbooks.content(runbookId),
+    queryFn: () => fetchRunbookContent(runbookId),
+    enabled: !!runbookId,
+  });
+}
+
+/**
+ * Hook for registering a runbook
+ */
+export function useRegisterRunbook(): UseMutationResult<Runbook, Error, RegisterRunbookRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: RegisterRunbookRequest) => registerRunbook(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.runbooks.all });
+    },
+  });
+}
+
+/**
+ * Hook for marking a runbook as reviewed
+ */
+export function useMarkRunbookReviewed(): UseMutationResult<Runbook, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (runbookId: string) => markRunbookReviewed(runbookId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.runbooks.all });
+    },
+  });
+}
+
+/**
+ * Hook for removing a runbook registration
+ */
+export function useDeleteRunbook(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (runbookId: string) => deleteRunbook(runbookId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.runbooks.all });
+    },
+  });
+}
@@ -0,0 +1,68 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchSavedViews,
+  createSavedView,
+  updateSavedView,
+  deleteSavedView,
+  SavedView,
+  SavedViewKind,
+  SavedViewsResponse,
+  CreateSavedViewRequest,
+  UpdateSavedViewRequest,
+} from '@/services/savedViewsApi';
+
+export function useSavedViews(kind?: SavedViewKind): UseQueryResult<SavedViewsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.savedViews.list(kind),
+    queryFn: () => fetchSavedViews(kind),
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+/**
+ * Hook for creating a saved view
+ */
+export function useCreateSavedView(): UseMutationResult<SavedView, Error, CreateSavedViewRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: CreateSavedViewRequest) => createSavedView(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.savedViews.all });
+    },
+  });
+}
+
+interface UpdateSavedViewVariables {
+  viewId: string;
+  request: UpdateSavedViewRequest;
+}
+
+/**
+ * Hook for updating a saved view
+ */
+export function useUpdateSavedView(): UseMutationResult<SavedView, Error, UpdateSavedViewVariables> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ viewId, request }: UpdateSavedViewVariables) => updateSavedView(viewId, request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.savedViews.all });
+    },
+  });
+}
+
+/**
+ * Hook for deleting a saved view
+ */
+export function useDeleteSavedView(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (viewId: string) => deleteSavedView(viewId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.savedViews.all });
+    },
+  });
+}
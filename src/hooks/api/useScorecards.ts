@@ -0,0 +1,41 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchComponentScorecard,
+  fetchTeamScorecards,
+  evaluateComponentScorecard,
+  ComponentScorecard,
+  TeamScorecardsResponse,
+} from '@/services/scorecardsApi';
+
+export function useComponentScorecard(componentId: string): UseQueryResult<ComponentScorecard, Error> {
+  return useQuery({
+    queryKey: queryKeys.scorecards.component(componentId),
+    queryFn: () => fetchComponentScorecard(componentId),
+    enabled: !!componentId,
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+export function useTeamScorecards(teamId?: string): UseQueryResult<TeamScorecardsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.scorecards.teams(teamId),
+    queryFn: () => fetchTeamScorecards(teamId),
+    staleTime: 5 * 60 * 1000, // Cache for 5 minutes
+  });
+}
+
+/**
+ * Hook for triggering a scorecard re-evaluation of a component
+ */
+export function useEvaluateComponentScorecard(): UseMutationResult<ComponentScorecard, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (componentId: string) => evaluateComponentScorecard(componentId),
+    onSuccess: (_data, componentId) => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.scorecards.component(componentId) });
+      queryClient.invalidateQueries({ queryKey: queryKeys.scorecards.teams() });
+    },
+  });
+}
@@ -0,0 +1,75 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchMySessions,
+  revokeSession,
+  revokeAllSessions,
+  fetchMySecurityEvents,
+  acknowledgeSecurityEvent,
+  UserSessionsResponse,
+  SecurityEvent,
+  SecurityEventsResponse,
+} from '@/services/sessionsApi';
+
+/**
+ * Hook to list the current user's active sessions
+ */
+export function useMySessions(): UseQueryResult<UserSessionsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.sessions.all,
+    queryFn: fetchMySessions,
+  });
+}
+
+/**
+ * Hook for revoking a single session
+ */
+export function useRevokeSession(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (sessionId: string) => revokeSession(sessionId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.sessions.all });
+    },
+  });
+}
+
+/**
+ * Hook for revoking all sessions except the current one
+ */
+export function useRevokeAllSessions(): UseMutationResult<void, Error, void> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: () => revokeAllSessions(),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.sessions.all });
+    },
+  });
+}
+
+/**
+ * Hook to list recent security events (failed logins, anomalous sign-ins)
+ */
+export function useMySecurityEvents(): UseQueryResult<SecurityEventsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.sessions.securityEvents(),
+    queryFn: fetchMySecurityEvents,
+    refetchInterval: 5 * 60 * 1000, // New anomalies should surface without a reload
+  });
+}
+
+/**
+ * Hook for acknowledging a security event
+ */
+export function useAcknowledgeSecurityEvent(): UseMutationResult<SecurityEvent, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (eventId: string) => acknowledgeSecurityEvent(eventId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.sessions.securityEvents() });
+    },
+  });
+}
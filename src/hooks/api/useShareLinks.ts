@@ -0,0 +1,48 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchShareLinks,
+  createShareLink,
+  revokeShareLink,
+  ShareLink,
+  ShareLinksResponse,
+  CreateShareLinkRequest,
+} from '@/services/shareLinksApi';
+
+/**
+ * Hook to list the current user's active share links
+ */
+export function useShareLinks(): UseQueryResult<ShareLinksResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.shareLinks.all,
+    queryFn: fetchShareLinks,
+  });
+}
+
+/**
+ * Hook for creating a signed share link
+ */
+export function useCreateShareLink(): UseMutationResult<ShareLink, Error, CreateShareLinkRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: createShareLink,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.shareLinks.all });
+    },
+  });
+}
+
+/**
+ * Hook for revoking a share link
+ */
+export function useRevokeShareLink(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: revokeShareLink,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.shareLinks.all });
+    },
+  });
+}
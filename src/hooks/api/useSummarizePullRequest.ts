@@ -0,0 +1,13 @@
+import { useMutation, UseMutationResult } from '@tanstack/react-query';
+import { summarizePullRequest } from '@/services/githubApi';
+import { PullRequestSummaryResponse, SummarizePullRequestParams } from '@/types/developer-portal';
+
+/**
+ * Hook for generating an AI summary of a pull request
+ * Exposed as a mutation because summaries are generated on demand.
+ */
+export function useSummarizePullRequest(): UseMutationResult<PullRequestSummaryResponse, Error, SummarizePullRequestParams> {
+  return useMutation({
+    mutationFn: (params: SummarizePullRequestParams) => summarizePullRequest(params),
+  });
+}
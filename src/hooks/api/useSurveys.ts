@@ -0,0 +1,76 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchSurveys,
+  createSurvey,
+  submitSurveyResponse,
+  fetchSurveyResults,
+  closeSurvey,
+  Survey,
+  SurveysResponse,
+  CreateSurveyRequest,
+  SurveyResponseSubmission,
+  SurveyResults,
+} from '@/services/surveysApi';
+
+/**
+ * Hook to list surveys targeted at the current user (or all, for admins)
+ */
+export function useSurveys(activeOnly?: boolean): UseQueryResult<SurveysResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.surveys.list(activeOnly),
+    queryFn: () => fetchSurveys(activeOnly),
+    staleTime: 10 * 60 * 1000,
+  });
+}
+
+/**
+ * Hook for creating a survey (admin only)
+ */
+export function useCreateSurvey(): UseMutationResult<Survey, Error, CreateSurveyRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: createSurvey,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.surveys.all });
+    },
+  });
+}
+
+/**
+ * Hook for submitting a survey response
+ */
+export function useSubmitSurveyResponse(
+  surveyId: string
+): UseMutationResult<void, Error, SurveyResponseSubmission> {
+  return useMutation({
+    mutationFn: (submission: SurveyResponseSubmission) =>
+      submitSurveyResponse(surveyId, submission),
+  });
+}
+
+/**
+ * Hook to fetch aggregated survey results (admin only)
+ */
+export function useSurveyResults(surveyId: string): UseQueryResult<SurveyResults, Error> {
+  return useQuery({
+    queryKey: queryKeys.surveys.results(surveyId),
+    queryFn: () => fetchSurveyResults(surveyId),
+    enabled: !!surveyId,
+  });
+}
+
+/**
+ * Hook for closing a survey to new responses (admin only)
+ */
+export function useCloseSurvey(): UseMutationResult<Survey, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: closeSurvey,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.surveys.all });
+    },
+  });
+}
@@ -0,0 +1,47 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchSystemMode,
+  updateSystemMode,
+  scheduleSystemModeWindow,
+  SystemModeResponse,
+  SystemModeWindow,
+  UpdateSystemModeRequest,
+} from '@/services/systemModeApi';
+
+export function useSystemMode(): UseQueryResult<SystemModeResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.systemMode.all,
+    queryFn: fetchSystemMode,
+    // Poll so admin dashboards notice mode flips without a reload
+    refetchInterval: 60 * 1000,
+  });
+}
+
+/**
+ * Hook for switching the system mode (admin only)
+ */
+export function useUpdateSystemMode(): UseMutationResult<SystemModeResponse, Error, UpdateSystemModeRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: UpdateSystemModeRequest) => updateSystemMode(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.systemMode.all });
+    },
+  });
+}
+
+/**
+ * Hook for scheduling a future maintenance/read-only window (admin only)
+ */
+export function useScheduleSystemModeWindow(): UseMutationResult<SystemModeResponse, Error, SystemModeWindow> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (window: SystemModeWindow) => scheduleSystemModeWindow(window),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.systemMode.all });
+    },
+  });
+}
@@ -0,0 +1,67 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchTeamSyncMappings,
+  upsertTeamSyncMapping,
+  triggerTeamSync,
+  deleteTeamSyncMapping,
+  TeamSyncMapping,
+  TeamSyncMappingsResponse,
+  TeamSyncResult,
+  UpsertTeamSyncMappingRequest,
+} from '@/services/teamSyncApi';
+
+/**
+ * Hook to fetch GitHub team sync mappings for a provider
+ */
+export function useTeamSyncMappings(provider: string): UseQueryResult<TeamSyncMappingsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.teamSync(provider),
+    queryFn: () => fetchTeamSyncMappings(provider),
+    enabled: !!provider,
+  });
+}
+
+/**
+ * Hook for creating or updating a team sync mapping
+ */
+export function useUpsertTeamSyncMapping(
+  provider: string
+): UseMutationResult<TeamSyncMapping, Error, UpsertTeamSyncMappingRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: UpsertTeamSyncMappingRequest) => upsertTeamSyncMapping(provider, request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.teamSync(provider) });
+    },
+  });
+}
+
+/**
+ * Hook for triggering an on-demand membership reconciliation
+ */
+export function useTriggerTeamSync(provider: string): UseMutationResult<TeamSyncResult, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (teamId: string) => triggerTeamSync(provider, teamId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.teamSync(provider) });
+    },
+  });
+}
+
+/**
+ * Hook for removing a team sync mapping
+ */
+export function useDeleteTeamSyncMapping(provider: string): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (teamId: string) => deleteTeamSyncMapping(provider, teamId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.teamSync(provider) });
+    },
+  });
+}
@@ -0,0 +1,85 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchTechRadar,
+  createTechRadarEntry,
+  updateTechRadarEntry,
+  deleteTechRadarEntry,
+  importTechRadarFromRepo,
+  TechRadarEntry,
+  TechRadarResponse,
+  UpsertTechRadarEntryRequest,
+} from '@/services/techRadarApi';
+
+export function useTechRadar(): UseQueryResult<TechRadarResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.techRadar.all,
+    queryFn: fetchTechRadar,
+    staleTime: 15 * 60 * 1000, // The radar changes rarely
+  });
+}
+
+/**
+ * Hook for creating a tech radar entry (admin only)
+ */
+export function useCreateTechRadarEntry(): UseMutationResult<TechRadarEntry, Error, UpsertTechRadarEntryRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: UpsertTechRadarEntryRequest) => createTechRadarEntry(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.techRadar.all });
+    },
+  });
+}
+
+interface UpdateTechRadarEntryVariables {
+  entryId: string;
+  request: UpsertTechRadarEntryRequest;
+}
+
+/**
+ * Hook for updating a tech radar entry (admin only)
+ */
+export function useUpdateTechRadarEntry(): UseMutationResult<TechRadarEntry, Error, UpdateTechRadarEntryVariables> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ entryId, request }: UpdateTechRadarEntryVariables) => updateTechRadarEntry(entryId, request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.techRadar.all });
+    },
+  });
+}
+
+/**
+ * Hook for deleting a tech radar entry (admin only)
+ */
+export function useDeleteTechRadarEntry(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (entryId: string) => deleteTechRadarEntry(entryId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.techRadar.all });
+    },
+  });
+}
+
+/**
+ * Hook for importing radar entries from a YAML file in a repo (admin only)
+ */
+export function useImportTechRadar(): UseMutationResult<
+  TechRadarResponse,
+  Error,
+  Parameters<typeof importTechRadarFromRepo>[0]
+> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: importTechRadarFromRepo,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.techRadar.all });
+    },
+  });
+}
@@ -0,0 +1,46 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchTemplateRepositories,
+  createTemplateRepository,
+  deleteTemplateRepository,
+  TemplateRepository,
+  TemplateRepositoriesResponse,
+  CreateTemplateRepositoryRequest,
+} from '@/services/templatesApi';
+
+export function useTemplateRepositories(category?: string): UseQueryResult<TemplateRepositoriesResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.templates.list(category),
+    queryFn: () => fetchTemplateRepositories(category),
+    staleTime: 15 * 60 * 1000, // Templates change rarely
+  });
+}
+
+/**
+ * Hook for registering a template repository (admin only)
+ */
+export function useCreateTemplateRepository(): UseMutationResult<TemplateRepository, Error, CreateTemplateRepositoryRequest> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: CreateTemplateRepositoryRequest) => createTemplateRepository(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.templates.all });
+    },
+  });
+}
+
+/**
+ * Hook for removing a template repository (admin only)
+ */
+export function useDeleteTemplateRepository(): UseMutationResult<void, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (templateId: string) => deleteTemplateRepository(templateId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.templates.all });
+    },
+  });
+}
@@ -0,0 +1,36 @@
+import { useQuery, useMutation, useQueryClient, UseQueryResult, UseMutationResult } from '@tanstack/react-query';
+import { queryKeys } from '@/lib/queryKeys';
+import {
+  fetchUndoableOperations,
+  undoOperation,
+  UndoableOperationsResponse,
+} from '@/services/githubApi';
+
+/**
+ * Hook to list recent destructive operations that can still be undone
+ */
+export function useUndoableOperations(
+  provider: string
+): UseQueryResult<UndoableOperationsResponse, Error> {
+  return useQuery({
+    queryKey: queryKeys.github.undoableOperations(provider),
+    queryFn: () => fetchUndoableOperations(provider),
+    enabled: !!provider,
+  });
+}
+
+/**
+ * Hook for undoing a deletion via a revert commit
+ */
+export function useUndoOperation(
+  provider: string
+): UseMutationResult<{ revert_commit_sha: string }, Error, string> {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (operationId: string) => undoOperation(provider, operationId),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.github.undoableOperations(provider) });
+    },
+  });
+}
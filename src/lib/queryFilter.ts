@@ -0,0 +1,67 @@
+/**
+ * Query Filter DSL
+ *
+ * Serializes structured filters and sorts into the query-string DSL the
+ * backend's shared filter parser understands, so list views don't invent
+ * ad-hoc params per endpoint.
+ *
+ * Wire format:
+ * - filter: repeated `field:op:value` entries, joined with commas
+ *   (e.g. `filter=name:like:auth,created_at:gt:2026-01-01`)
+ * - sort: comma-separated fields, `-` prefix for descending
+ *   (e.g. `sort=-created_at,name`)
+ *
+ * Values are percent-encoded so the `,` / `:` / `|` delimiters cannot be
+ * injected through user input; the backend parser decodes each value after
+ * splitting on the delimiters.
+ */
+
+export type FilterOperator = 'eq' | 'ne' | 'like' | 'gt' | 'gte' | 'lt' | 'lte' | 'in';
+
+export interface QueryFilter {
+  field: string;
+  op: FilterOperator;
+  value: string | number | boolean | Array<string | number>;
+}
+
+export interface QuerySort {
+  field: string;
+  direction?: 'asc' | 'desc'; // Default: asc
+}
+
+function serializeValue(value: QueryFilter['value']): string {
+  if (Array.isArray(value)) {
+    return value.map((entry) => encodeURIComponent(String(entry))).join('|');
+  }
+  return encodeURIComponent(String(value));
+}
+
+/**
+ * Serialize a single filter into the `field:op:value` form
+ */
+export function serializeFilter(filter: QueryFilter): string {
+  return `${filter.field}:${filter.op}:${serializeValue(filter.value)}`;
+}
+
+/**
+ * Build query params for a filtered, sorted list request
+ *
+ * @example
+ * const params = buildFilterParams(
+ *   [{ field: 'name', op: 'like', value: 'auth' }],
+ *   [{ field: 'created_at', direction: 'desc' }]
+ * );
+ * // { filter: 'name:like:auth', sort: '-created_at' }
+ */
+export function buildFilterParams(
+  filters?: QueryFilter[],
+  sorts?: QuerySort[]
+): Record<string, string | undefined> {
+  return {
+    filter: filters && filters.length > 0 ? filters.map(serializeFilter).join(',') : undefined,
+    sort:
+      sorts && sorts.length > 0
+        ? sorts.map((s) => (s.direction === 'desc' ? `-${s.field}` : s.field)).join(',')
+        : undefined,
+  };
+}
@@ -19,6 +19,12 @@ export const queryKeys = {
     details: () => [...queryKeys.teams.all, 'detail'] as const,
     detail: (id: string) => [...queryKeys.teams.details(), id] as const,
     members: (teamId: string) => [...queryKeys.teams.all, 'members', teamId] as const,
+    calendar: (teamId: string, from?: string, to?: string) =>
+      [...queryKeys.teams.all, 'calendar', teamId, from, to] as const,
+    defaultLinks: (teamId: string) =>
+      [...queryKeys.teams.all, 'default-links', teamId] as const,
+    metricGoals: (teamId: string) =>
+      [...queryKeys.teams.all, 'metric-goals', teamId] as const,
   },
 
   // Updated members section in queryKeys
@@ -80,6 +86,8 @@ export const queryKeys = {
       [...queryKeys.components.all, 'by-organization', organizationId] as const,
     byProject: (projectName: string) =>
       [...queryKeys.components.all, 'by-project', projectName] as const,
+    graph: (rootId?: string, depth?: number) =>
+      [...queryKeys.components.all, 'graph', rootId, depth] as const,
   },
 
   landscapes: {
@@ -134,6 +142,8 @@ export const queryKeys = {
       count: (params: Record<string, any>) =>
         [...queryKeys.jira.myIssues.all(), 'count', params] as const,
     },
+    teamSprint: (team: string) => [...queryKeys.jira.all, 'team-sprint', team] as const,
+    teamEpics: (team: string) => [...queryKeys.jira.all, 'team-epics', team] as const,
   },
 
   quickLinks: {
@@ -146,9 +156,189 @@ export const queryKeys = {
     pullRequests: (params?: Record<string, any>) =>
       [...queryKeys.github.all, 'pull-requests', params] as const,
     contributions: () => [...queryKeys.github.all, 'contributions'] as const,
-    averagePRTime: (period?: string) => [...queryKeys.github.all, 'average-pr-time', period] as const,
+    averagePRTime: (period?: string, teamId?: string, series?: Record<string, any>) =>
+      [...queryKeys.github.all, 'average-pr-time', period, teamId, series] as const,
     heatmap: () => [...queryKeys.github.all, 'heatmap'] as const,
-    prReviewComments: (period?: string) => [...queryKeys.github.all, 'pr-review-comments', period] as const,
+    prReviewComments: (period?: string, teamId?: string, series?: Record<string, any>) =>
+      [...queryKeys.github.all, 'pr-review-comments', period, teamId, series] as const,
+    teamSync: (provider: string) =>
+      [...queryKeys.github.all, 'team-sync', provider] as const,
+    metricAnomalies: (teamId?: string) =>
+      [...queryKeys.github.all, 'metric-anomalies', teamId] as const,
+    discussions: (provider: string, owner: string, repo: string, unanswered?: boolean) =>
+      [...queryKeys.github.all, 'discussions', provider, owner, repo, unanswered] as const,
+    unansweredDiscussions: (teamId: string) =>
+      [...queryKeys.github.all, 'discussions', 'unanswered', teamId] as const,
+    myProjectItems: (provider: string) =>
+      [...queryKeys.github.all, 'project-items', provider, 'me'] as const,
+    teamProjectItems: (provider: string, teamId: string) =>
+      [...queryKeys.github.all, 'project-items', provider, teamId] as const,
+    settingsAudit: (provider: string, owner: string, repo: string) =>
+      [...queryKeys.github.all, 'settings-audit', provider, owner, repo] as const,
+    undoableOperations: (provider: string) =>
+      [...queryKeys.github.all, 'undoable-operations', provider] as const,
+    drafts: (provider: string, owner: string, repo: string) =>
+      [...queryKeys.github.all, 'drafts', provider, owner, repo] as const,
+    editLocks: (provider: string, owner: string, repo: string) =>
+      [...queryKeys.github.all, 'edit-locks', provider, owner, repo] as const,
+  },
+
+  commands: {
+    all: ['commands'] as const,
+  },
+
+  systemMode: {
+    all: ['system-mode'] as const,
+  },
+
+  changelog: {
+    all: ['changelog'] as const,
+    list: (limit?: number) => [...queryKeys.changelog.all, 'list', limit] as const,
+  },
+
+  surveys: {
+    all: ['surveys'] as const,
+    list: (activeOnly?: boolean) => [...queryKeys.surveys.all, 'list', activeOnly] as const,
+    results: (surveyId: string) => [...queryKeys.surveys.all, 'results', surveyId] as const,
+  },
+
+  directory: {
+    all: ['directory'] as const,
+    list: (params?: Record<string, any>) => [...queryKeys.directory.all, 'list', params] as const,
+    managers: (userId: string) => [...queryKeys.directory.all, 'managers', userId] as const,
+    activity: (userId: string) => [...queryKeys.directory.all, 'activity', userId] as const,
+    mySkills: () => [...queryKeys.directory.all, 'my-skills'] as const,
+    experts: (skill: string) => [...queryKeys.directory.all, 'experts', skill] as const,
+  },
+
+  shareLinks: {
+    all: ['share-links'] as const,
+  },
+
+  recent: {
+    all: ['recent'] as const,
+    list: (limit?: number) => [...queryKeys.recent.all, 'list', limit] as const,
+  },
+
+  favorites: {
+    all: ['favorites'] as const,
+  },
+
+  sessions: {
+    all: ['sessions'] as const,
+    securityEvents: () => [...queryKeys.sessions.all, 'security-events'] as const,
+  },
+
+  accessReview: {
+    all: ['access-review'] as const,
+    report: (teamId?: string) => [...queryKeys.accessReview.all, 'report', teamId] as const,
+  },
+
+  dependencies: {
+    all: ['dependencies'] as const,
+    search: (params?: Record<string, any>) =>
+      [...queryKeys.dependencies.all, 'search', params] as const,
+    licenses: () => [...queryKeys.dependencies.all, 'licenses'] as const,
+    sboms: (componentId: string) =>
+      [...queryKeys.dependencies.all, 'sboms', componentId] as const,
+    sbomDiff: (componentId: string, from: string, to: string) =>
+      [...queryKeys.dependencies.all, 'sbom-diff', componentId, from, to] as const,
+    vulnerabilities: (componentId: string, minSeverity?: string) =>
+      [...queryKeys.dependencies.all, 'vulnerabilities', componentId, minSeverity] as const,
+  },
+
+  reminders: {
+    all: ['reminders'] as const,
+  },
+
+  notificationRules: {
+    all: ['notification-rules'] as const,
+  },
+
+  attachments: {
+    all: ['attachments'] as const,
+    list: (context?: string, teamId?: string) =>
+      [...queryKeys.attachments.all, 'list', context, teamId] as const,
+    quota: (teamId: string) => [...queryKeys.attachments.all, 'quota', teamId] as const,
+  },
+
+  approvals: {
+    all: ['approvals'] as const,
+    list: (role: string, status?: string) =>
+      [...queryKeys.approvals.all, 'list', role, status] as const,
+  },
+
+  policies: {
+    all: ['policies'] as const,
+    decisions: (policyId: string) => [...queryKeys.policies.all, 'decisions', policyId] as const,
+  },
+
+  providerMigrations: {
+    all: ['provider-migrations'] as const,
+  },
+
+  retention: {
+    all: ['retention-policies'] as const,
+    archives: (dataType?: string) =>
+      [...queryKeys.retention.all, 'archives', dataType] as const,
+  },
+
+  adminStats: {
+    all: ['admin-stats'] as const,
+    window: (window: string) => [...queryKeys.adminStats.all, window] as const,
+    slos: (window: string) => [...queryKeys.adminStats.all, 'slos', window] as const,
+  },
+
+  runbooks: {
+    all: ['runbooks'] as const,
+    list: (params?: Record<string, any>) => [...queryKeys.runbooks.all, 'list', params] as const,
+    content: (id: string) => [...queryKeys.runbooks.all, 'content', id] as const,
+  },
+
+  incidents: {
+    all: ['incidents'] as const,
+    lists: () => [...queryKeys.incidents.all, 'list'] as const,
+    list: (params?: Record<string, any>) => [...queryKeys.incidents.lists(), params] as const,
+    detail: (id: string) => [...queryKeys.incidents.all, 'detail', id] as const,
+    postmortem: (id: string) => [...queryKeys.incidents.all, 'postmortem', id] as const,
+  },
+
+  costs: {
+    all: ['costs'] as const,
+    list: (params?: Record<string, any>) => [...queryKeys.costs.all, 'list', params] as const,
+    anomalies: (params?: Record<string, any>) => [...queryKeys.costs.all, 'anomalies', params] as const,
+  },
+
+  scorecards: {
+    all: ['scorecards'] as const,
+    component: (componentId: string) => [...queryKeys.scorecards.all, 'component', componentId] as const,
+    teams: (teamId?: string) => [...queryKeys.scorecards.all, 'teams', teamId] as const,
+  },
+
+  techRadar: {
+    all: ['tech-radar'] as const,
+  },
+
+  templates: {
+    all: ['templates'] as const,
+    list: (category?: string) => [...queryKeys.templates.all, 'list', category] as const,
+  },
+
+  savedViews: {
+    all: ['saved-views'] as const,
+    list: (kind?: string) => [...queryKeys.savedViews.all, 'list', kind] as const,
+  },
+
+  preferences: {
+    all: ['preferences'] as const,
+    namespace: (namespace: string) => [...queryKeys.preferences.all, namespace] as const,
+    workingHours: () => [...queryKeys.preferences.all, 'working-hours'] as const,
+  },
+
+  leaderboard: {
+    all: ['leaderboard'] as const,
+    list: (params?: Record<string, any>) => [...queryKeys.leaderboard.all, 'list', params] as const,
+    optOut: () => [...queryKeys.leaderboard.all, 'opt-out'] as const,
   },
 
   sonar: {
@@ -172,10 +362,14 @@ export const queryKeys = {
 
   links: {
     all: ['links'] as const,
+    filtered: (filter?: string, sort?: string, fields?: string) =>
+      [...queryKeys.links.all, 'filtered', filter, sort, fields] as const,
+    duplicates: (teamId?: string) => [...queryKeys.links.all, 'duplicates', teamId] as const,
   },
 
   categories: {
     all: ['categories'] as const,
+    stats: () => [...queryKeys.categories.all, 'stats'] as const,
   },
 
   triggeredAlerts: {
@@ -0,0 +1,60 @@
+/**
+ * URL Validation
+ *
+ * Client-side mirror of the backend's outbound URL policy (scheme allowlist,
+ * private range blocking). The backend remains the enforcement point; these
+ * checks exist so link and webhook forms can reject bad URLs before a round
+ * trip instead of surfacing a 400 afterwards.
+ */
+
+const ALLOWED_SCHEMES = ['http:', 'https:'];
+
+// Hostname literals the backend's anti-SSRF policy always rejects
+const BLOCKED_HOST_PATTERNS = [
+  /^localhost$/i,
+  /^127\./,
+  /^0\./,
+  /^10\./,
+  /^192\.168\./,
+  /^172\.(1[6-9]|2\d|3[01])\./,
+  /^169\.254\./, // Link-local / cloud metadata
+  /^\[?::1\]?$/,
+  /^\[?fe80:/i,
+  /^\[?f[cd][0-9a-f]{2}:/i, // IPv6 unique local
+];
+
+export interface UrlValidationResult {
+  valid: boolean;
+  reason?: string;
+}
+
+/**
+ * Validate a user-supplied URL against the outbound URL policy
+ *
+ * @example
+ * const result = validateExternalUrl('http://169.254.169.254/');
+ * // { valid: false, reason: 'Host is not allowed' }
+ */
+export function validateExternalUrl(rawUrl: string): UrlValidationResult {
+  let url: URL;
+
+  try {
+    url = new URL(rawUrl);
+  } catch {
+    return { valid: false, reason: 'Not a valid URL' };
+  }
+
+  if (!ALLOWED_SCHEMES.includes(url.protocol)) {
+    return { valid: false, reason: `Scheme ${url.protocol.replace(':', '')} is not allowed` };
+  }
+
+  if (url.username || url.password) {
+    return { valid: false, reason: 'Credentials in URLs are not allowed' };
+  }
+
+  if (BLOCKED_HOST_PATTERNS.some((pattern) => pattern.test(url.hostname))) {
+    return { valid: false, reason: 'Host is not allowed' };
+  }
+
+  return { valid: true };
+}
@@ -41,6 +41,16 @@ const API_CONFIG = {
  */
 type HttpMethod = 'GET' | 'POST' | 'PUT' | 'DELETE' | 'PATCH';
 
+/**
+ * Read the CSRF token issued by the backend security middleware.
+ * The token is set as a readable cookie on cookie-authenticated flows
+ * (e.g. the refresh endpoint) and must be echoed back on mutating requests.
+ */
+function getCsrfToken(): string | null {
+  const match = document.cookie.match(/(?:^|;\s*)XSRF-TOKEN=([^;]+)/);
+  return match ? decodeURIComponent(match[1]) : null;
+}
+
 /**
  * Request configuration options
  */
@@ -191,6 +201,14 @@ export class ApiClient {
     // Build headers with auth token
     const headers = this.buildHeaders(customHeaders);
 
+    // Echo the CSRF token back on mutating requests; GETs are exempt
+    if (method !== 'GET') {
+      const csrfToken = getCsrfToken();
+      if (csrfToken) {
+        headers['X-CSRF-Token'] = csrfToken;
+      }
+    }
+
     // Build fetch options
     const fetchOptions: FetchOptions = {
       method,
@@ -296,6 +314,40 @@ export class ApiClient {
       details: errorBody.details || errorBody.error || undefined,
     };
 
+    // 413s from the body-size middleware include the configured limit;
+    // surface it so upload UIs can tell the user how much is too much
+    if (response.status === 413 && errorBody.max_bytes) {
+      error.message = `Request too large: limit is ${errorBody.max_bytes} bytes`;
+      error.details = { ...error.details, max_bytes: errorBody.max_bytes };
+    }
+
+    // The error taxonomy adds a machine code and retryability metadata
+    if (errorBody.code) {
+      error.code = errorBody.code;
+    }
+    if (errorBody.retry_after !== undefined) {
+      error.retryAfter = Number(errorBody.retry_after);
+    } else {
+      const retryAfterHeader = response.headers?.get('Retry-After');
+      if (retryAfterHeader) {
+        error.retryAfter = Number(retryAfterHeader);
+      }
+    }
+
+    // Validation failures carry field-level errors in the envelope,
+    // e.g. { errors: { per_page: "must be between 1 and 100" } }
+    if (errorBody.errors && typeof errorBody.errors === 'object') {
+      error.fieldErrors = errorBody.errors;
+    }
+
+    // Upstream correlation ID (X-GitHub-Request-Id / AI Core trace id),
+    // propagated by the backend for support tickets to the provider team
+    const upstreamId =
+      errorBody.upstream_request_id || response.headers?.get('X-Upstream-Request-Id');
+    if (upstreamId) {
+      error.upstreamRequestId = upstreamId;
+    }
+
     return error;
   }
 
@@ -36,3 +36,42 @@ export async function fetchComponentsByProject(
     params: { 'project-name': projectName, page_size: DEFAULT_PAGE_SIZE }
   });
 }
+
+/**
+ * A node in the catalog dependency graph
+ */
+export interface CatalogGraphNode {
+  id: string;
+  kind: 'component' | 'api' | 'system' | 'team';
+  name: string;
+  title?: string;
+}
+
+/**
+ * A relation edge between two catalog entities
+ */
+export interface CatalogGraphEdge {
+  source: string; // Node ID
+  target: string; // Node ID
+  relation: 'depends-on' | 'contains' | 'owns' | 'consumes';
+}
+
+export interface CatalogGraphResponse {
+  nodes: CatalogGraphNode[];
+  edges: CatalogGraphEdge[];
+}
+
+/**
+ * Fetch the catalog dependency graph for visualization
+ *
+ * @param rootId - Optional entity to scope the graph around
+ * @param depth - Optional traversal depth from the root
+ */
+export async function fetchCatalogGraph(
+  rootId?: string,
+  depth?: number
+): Promise<CatalogGraphResponse> {
+  return apiClient.get<CatalogGraphResponse>('/catalog/graph', {
+    params: { root_id: rootId, depth },
+  });
+}
@@ -36,7 +36,61 @@ export async function fetchMyJiraIssues(params: MyJiraIssuesParams): Promise<Jir
  * Fetch count of my Jira issues by status
  */
 export async function fetchMyJiraIssuesCount(params: MyJiraIssuesCountParams): Promise<JiraIssuesCountResponse> {
-  return apiClient.get<JiraIssuesCountResponse>(`/jira/issues/me/count`, { 
+  return apiClient.get<JiraIssuesCountResponse>(`/jira/issues/me/count`, {
     params: params as unknown as Record<string, string | number | boolean | undefined>
   });
 }
+
+/**
+ * One day of a sprint burndown
+ */
+export interface SprintBurndownDay {
+  date: string; // yyyy-MM-dd
+  remaining_points: number;
+  ideal_points: number;
+}
+
+/**
+ * Sprint rollup for a team, resolved from the team's Jira board mapping
+ */
+export interface TeamSprintRollup {
+  sprint_id: string;
+  sprint_name: string;
+  start_date: string;
+  end_date: string;
+  total_points: number;
+  completed_points: number;
+  burndown: SprintBurndownDay[];
+}
+
+/**
+ * Epic progress for a team
+ */
+export interface TeamEpicProgress {
+  epic_key: string;
+  epic_name: string;
+  total_issues: number;
+  done_issues: number;
+  progress_percent: number;
+  link: string;
+}
+
+export interface TeamEpicsResponse {
+  epics: TeamEpicProgress[];
+}
+
+/**
+ * Fetch the active sprint burndown for a team (cached server-side)
+ *
+ * Requires the team to be mapped to a Jira board in team metadata.
+ */
+export async function fetchTeamSprintRollup(team: string): Promise<TeamSprintRollup> {
+  return apiClient.get<TeamSprintRollup>(`/jira/teams/${team}/sprint`);
+}
+
+/**
+ * Fetch epic progress for a team (cached server-side)
+ */
+export async function fetchTeamEpics(team: string): Promise<TeamEpicsResponse> {
+  return apiClient.get<TeamEpicsResponse>(`/jira/teams/${team}/epics`);
+}
@@ -77,6 +77,70 @@ function buildSwaggerUIUrl(
   return `https://${componentName}.cfapps.${domain}/api?scope=cis-system`;
 }
 
+/**
+ * A registered API definition parsed and validated by the backend
+ */
+export interface ApiDefinition {
+  id: string;
+  component_id: string;
+  spec_type: 'openapi' | 'asyncapi';
+  /** Path of the spec file within the component's repo */
+  spec_path: string;
+  version: string;
+  title: string;
+  endpoint_count: number;
+  valid: boolean;
+  validation_errors?: string[];
+  ingested_at: string;
+}
+
+export interface ApiDefinitionsResponse {
+  definitions: ApiDefinition[];
+}
+
+/**
+ * A diff between two stored versions of an API definition
+ */
+export interface ApiDefinitionDiff {
+  from_version: string;
+  to_version: string;
+  added_endpoints: string[];
+  removed_endpoints: string[];
+  changed_endpoints: string[];
+  breaking: boolean;
+}
+
+/**
+ * Fetch the registered API definitions for a component
+ */
+export async function fetchApiDefinitions(componentId: string): Promise<ApiDefinitionsResponse> {
+  return apiClient.get<ApiDefinitionsResponse>(`/api-definitions`, {
+    params: { component_id: componentId },
+  });
+}
+
+/**
+ * Fetch the parsed spec of a definition version for rendering
+ */
+export async function fetchApiDefinitionSpec(definitionId: string, version?: string): Promise<SwaggerApiResponse> {
+  return apiClient.get<SwaggerApiResponse>(`/api-definitions/${definitionId}/spec`, {
+    params: version ? { version } : undefined,
+  });
+}
+
+/**
+ * Fetch the diff between two stored versions of a definition
+ */
+export async function fetchApiDefinitionDiff(
+  definitionId: string,
+  fromVersion: string,
+  toVersion: string
+): Promise<ApiDefinitionDiff> {
+  return apiClient.get<ApiDefinitionDiff>(`/api-definitions/${definitionId}/diff`, {
+    params: { from: fromVersion, to: toVersion },
+  });
+}
+
 /**
  * Fetch Swagger API schema through backend proxy
  * Uses apiClient to ensure proper authentication with Authorization header
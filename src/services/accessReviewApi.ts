@@ -0,0 +1,41 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * One row of an access review report: a user and everything they hold
+ */
+export interface AccessReviewEntry {
+  user_id: string;
+  user_name: string;
+  email: string;
+  roles: string[];
+  team_memberships: string[];
+  provider_tokens: string[];
+  api_key_count: number;
+  last_active_at?: string;
+}
+
+export interface AccessReviewReport {
+  generated_at: string;
+  entries: AccessReviewEntry[];
+}
+
+/**
+ * Fetch the current access review report (admin only)
+ *
+ * @param teamId - Optional team filter
+ */
+export async function fetchAccessReviewReport(teamId?: string): Promise<AccessReviewReport> {
+  return apiClient.get<AccessReviewReport>('/admin/access-review', {
+    params: { team_id: teamId },
+  });
+}
+
+/**
+ * Export the access review report as CSV for quarterly security reviews
+ */
+export async function exportAccessReviewCsv(teamId?: string): Promise<Blob> {
+  return apiClient.getBinary('/admin/access-review', {
+    params: { team_id: teamId, format: 'csv' },
+    headers: { Accept: 'text/csv' },
+  });
+}
@@ -0,0 +1,49 @@
+import { apiClient } from './ApiClient';
+
+export type AdminStatsWindow = '24h' | '7d' | '30d' | '90d';
+
+/**
+ * Aggregate portal stats for the admin dashboard
+ */
+export interface AdminStats {
+  window: AdminStatsWindow;
+  active_users: number;
+  requests_per_endpoint: Record<string, number>;
+  provider_error_rates: Record<string, number>; // Fraction of failed requests per provider
+  ai_tokens_consumed: number;
+  storage_bytes: number;
+  storage_growth_bytes: number;
+  generated_at: string;
+}
+
+/**
+ * Fetch aggregate portal stats over a selectable window (admin only)
+ */
+export async function fetchAdminStats(window: AdminStatsWindow = '7d'): Promise<AdminStats> {
+  return apiClient.get<AdminStats>('/admin/stats', { params: { window } });
+}
+
+/**
+ * SLO compliance for one route group, computed from the metrics middleware
+ */
+export interface SloStatus {
+  route_group: string;
+  latency_target_ms: number;
+  latency_p99_ms: number;
+  error_budget: number; // Allowed error fraction
+  error_rate: number;
+  burn_rate: number; // >1 means the budget is being consumed too fast
+  compliant: boolean;
+}
+
+export interface SlosResponse {
+  slos: SloStatus[];
+  window: string;
+}
+
+/**
+ * Fetch per-route-group SLO compliance and burn rates (admin only)
+ */
+export async function fetchAdminSlos(window: AdminStatsWindow = '7d'): Promise<SlosResponse> {
+  return apiClient.get<SlosResponse>('/admin/slos', { params: { window } });
+}
@@ -152,6 +152,32 @@ export interface ModelsResponse {
   capabilities: string[];
 }
 
+// Result of a minimal probe request against a deployment
+export interface DeploymentHealth {
+  deploymentId: string;
+  status: 'healthy' | 'degraded' | 'unhealthy';
+  latencyMs: number;
+  /** True when a scheduler job keeps this deployment warm */
+  keepWarm: boolean;
+  checkedAt: string;
+}
+
+// Named parameter bundles ("precise", "creative") managed per team
+export interface ParameterPreset {
+  id: string;
+  name: string;
+  description?: string;
+  team?: string;
+  temperature?: number;
+  top_p?: number;
+  max_tokens?: number;
+}
+
+export interface ParameterPresetsResponse {
+  count: number;
+  presets: ParameterPreset[];
+}
+
 // Import the API client for making real backend calls
 import { apiClient } from '@/services/ApiClient';
 
@@ -224,6 +250,20 @@ class AIApiClient {
     return apiClient.post<Configuration>('/ai-core/configurations', configData);
   }
 
+  async getDeploymentHealth(deploymentId: string): Promise<DeploymentHealth> {
+    return apiClient.get<DeploymentHealth>(`/ai-core/deployments/${deploymentId}/health`);
+  }
+
+  async getParameterPresets(team?: string): Promise<ParameterPresetsResponse> {
+    const queryParams: Record<string, string | number | boolean | undefined> = {};
+
+    if (team) queryParams.team = team;
+
+    return apiClient.get<ParameterPresetsResponse>('/ai-core/parameter-presets', {
+      params: queryParams
+    });
+  }
+
   async getAllFoundationModels(params?: {
     search?: string;
     provider?: string;
@@ -348,6 +388,23 @@ export const useCreateConfiguration = () => {
   });
 };
 
+export const useDeploymentHealth = (deploymentId: string | null) => {
+  return useQuery({
+    queryKey: ['deployments', deploymentId, 'health'],
+    queryFn: () => aiApiClient.getDeploymentHealth(deploymentId!),
+    enabled: !!deploymentId,
+    staleTime: 60 * 1000, // Probe results are short-lived
+  });
+};
+
+export const useParameterPresets = (team?: string) => {
+  return useQuery({
+    queryKey: ['parameter-presets', team],
+    queryFn: () => aiApiClient.getParameterPresets(team),
+    staleTime: 15 * 60 * 1000, // 15 minutes
+  });
+};
+
 export const useFoundationModels = (params?: { search?: string; provider?: string }) => {
   return useQuery({
     queryKey: ['foundation-models', params],
@@ -392,14 +449,39 @@ export interface ChatMessage {
   content: string | ChatMessageContent[]; // Support both simple text and multimodal content
 }
 
+// Gemini safety settings forwarded as-is to the upstream request
+export interface GeminiSafetySetting {
+  category: string; // e.g., 'HARM_CATEGORY_DANGEROUS_CONTENT'
+  threshold: string; // e.g., 'BLOCK_MEDIUM_AND_ABOVE'
+}
+
+// Grounding/citation metadata forwarded from Gemini responses
+export interface InferenceCitation {
+  uri?: string;
+  title?: string;
+  start_index?: number;
+  end_index?: number;
+}
+
 export interface ChatInferenceRequest {
+  /** Deployment ID or a routing alias (e.g., 'default-chat') resolved by the backend */
   deploymentId: string;
   messages: ChatMessage[];
   max_tokens?: number;
   temperature?: number;
   top_p?: number;
+  /** Reference a named parameter preset instead of raw values */
+  preset_id?: string;
   stream?: boolean;
   onChunk?: (content: string) => void; // Callback for streaming chunks
+  /** Called with accumulated thinking tokens (Claude extended thinking) */
+  onThinking?: (thinking: string) => void;
+  /** Called when the model streams a tool_use block */
+  onToolUse?: (toolUse: { id: string; name: string; inputJson: string }) => void;
+  /** Per-request Gemini safety settings (org defaults apply otherwise) */
+  safety_settings?: GeminiSafetySetting[];
+  /** Called when grounding/citation metadata arrives (Gemini) */
+  onCitations?: (citations: InferenceCitation[]) => void;
 }
 
 export interface ChatInferenceResponse {
@@ -418,6 +500,25 @@ export interface ChatInferenceResponse {
     total_tokens: number;
   };
   system_fingerprint?: string;
+  /** Grounding/citation metadata when the provider returns it (Gemini) */
+  citations?: InferenceCitation[];
+  /** Set when the backend capped max_tokens to fit the model's context window */
+  context_warning?: ContextWindowWarning;
+  /**
+   * Set when the backend's backpressure buffer overflowed (slow client) and
+   * the remaining output was replaced with a summarized final message
+   */
+  truncated?: boolean;
+}
+
+// Structured warning emitted when prompt+completion wouldn't fit the context
+// window; the backend auto-caps max_tokens and reports what it did here
+export interface ContextWindowWarning {
+  prompt_tokens: number;
+  context_length: number;
+  /** The capped value actually sent upstream, 0 if the prompt alone overflows */
+  adjusted_max_tokens: number;
+  message: string;
 }
 
 // Add chat inference to API client
@@ -454,6 +555,21 @@ async function handleStreamingInference(request: ChatInferenceRequest): Promise<
 
   if (!response.ok) {
     const error = await response.json();
+
+    // 429 means the deployment's bounded queue is full; the backend includes
+    // an estimated wait so the UI can tell the user when to retry
+    if (response.status === 429) {
+      const waitSeconds = error.estimated_wait_seconds;
+      const queueError = new Error(
+        waitSeconds
+          ? `Deployment is busy. Estimated wait: ${waitSeconds}s`
+          : 'Deployment is busy. Please retry shortly.'
+      ) as Error & { status?: number; estimatedWaitSeconds?: number };
+      queueError.status = 429;
+      queueError.estimatedWaitSeconds = waitSeconds;
+      throw queueError;
+    }
+
     throw new Error(error.error || 'Failed to start streaming inference');
   }
 
@@ -465,6 +581,8 @@ async function handleStreamingInference(request: ChatInferenceRequest): Promise<
   const reader = response.body.getReader();
   const decoder = new TextDecoder();
   let accumulatedContent = '';
+  let accumulatedThinking = '';
+  let currentToolUse: { id: string; name: string; inputJson: string } | null = null;
   let inferenceResponse: ChatInferenceResponse = {
     id: `stream-${Date.now()}`,
     object: 'chat.completion',
@@ -497,6 +615,18 @@ async function handleStreamingInference(request: ChatInferenceRequest): Promise<
       const lines = chunk.split('\n');
 
       for (const line of lines) {
+        // SSE comment lines (": keep-alive") are heartbeats sent so proxies
+        // don't drop idle streams - ignore them
+        if (line.startsWith(':')) {
+          continue;
+        }
+
+        // Reconnection hints from the server; tracked but reconnection is
+        // handled by the caller retrying the mutation
+        if (line.startsWith('retry:') || line.startsWith('id:')) {
+          continue;
+        }
+
         if (line.startsWith('data: ')) {
           const data = line.substring(6).trim();
 
@@ -509,6 +639,11 @@ async function handleStreamingInference(request: ChatInferenceRequest): Promise<
           try {
             const parsed = JSON.parse(data);
 
+            // Explicit heartbeat events carry no content
+            if (parsed.type === 'heartbeat') {
+              continue;
+            }
+
             // Handle OpenAI/GPT format
             if (parsed.choices && parsed.choices[0]) {
               const choice = parsed.choices[0];
@@ -545,6 +680,58 @@ async function handleStreamingInference(request: ChatInferenceRequest): Promise<
               }
             }
 
+            // Anthropic thinking tokens - surfaced separately from the answer
+            if (parsed.type === 'content_block_delta' && parsed.delta?.type === 'thinking_delta' && parsed.delta.thinking) {
+              accumulatedThinking += parsed.delta.thinking;
+              if (request.onThinking) {
+                request.onThinking(accumulatedThinking);
+              }
+            }
+
+            // Anthropic tool_use blocks - name arrives on block start, the
+            // input JSON is streamed as partial deltas until block stop
+            if (parsed.type === 'content_block_start' && parsed.content_block?.type === 'tool_use') {
+              currentToolUse = {
+                id: parsed.content_block.id || '',
+                name: parsed.content_block.name || '',
+                inputJson: '',
+              };
+            }
+            if (parsed.type === 'content_block_delta' && parsed.delta?.type === 'input_json_delta' && currentToolUse) {
+              currentToolUse.inputJson += parsed.delta.partial_json || '';
+            }
+            if (parsed.type === 'content_block_stop' && currentToolUse) {
+              if (request.onToolUse) {
+                request.onToolUse(currentToolUse);
+              }
+              currentToolUse = null;
+            }
+
+            // The backend dropped buffered chunks for this slow connection and
+            // sent a summarized final message instead of the full remainder
+            if (parsed.type === 'stream_truncated') {
+              inferenceResponse.truncated = true;
+              if (parsed.summary) {
+                accumulatedContent += parsed.summary;
+                inferenceResponse.choices[0].message.content = accumulatedContent;
+              }
+              continue;
+            }
+
+            // Context window warning - the backend adjusted max_tokens
+            if (parsed.type === 'context_warning') {
+              inferenceResponse.context_warning = parsed as ContextWindowWarning;
+              continue;
+            }
+
+            // Gemini grounding/citation metadata, normalized by the backend
+            if (Array.isArray(parsed.citations) && parsed.citations.length > 0) {
+              inferenceResponse.citations = parsed.citations;
+              if (request.onCitations) {
+                request.onCitations(parsed.citations);
+              }
+            }
+
             // Update metadata
             if (parsed.id) inferenceResponse.id = parsed.id;
             if (parsed.model) inferenceResponse.model = parsed.model;
@@ -563,6 +750,336 @@ async function handleStreamingInference(request: ChatInferenceRequest): Promise<
   return inferenceResponse;
 }
 
+// Generation types - commit messages and changelogs
+export interface GenerateCommitMessageRequest {
+  diff: string; // Unified diff of the pending changes
+  model?: string; // Optional model override, backend default otherwise
+}
+
+export interface GenerateCommitMessageResponse {
+  message: string;
+  model: string;
+}
+
+export interface GenerateChangelogRequest {
+  provider: string;
+  owner: string;
+  repo: string;
+  from_tag: string;
+  to_tag: string;
+  model?: string;
+}
+
+export interface GenerateChangelogResponse {
+  changelog: string; // Markdown changelog between the two tags
+  commit_count: number;
+  model: string;
+}
+
+// Generate a commit message from a diff via AI Core
+export const useGenerateCommitMessage = () => {
+  return useMutation({
+    mutationFn: (request: GenerateCommitMessageRequest): Promise<GenerateCommitMessageResponse> =>
+      apiClient.post<GenerateCommitMessageResponse>('/ai-core/generate/commit-message', request),
+  });
+};
+
+// Generate a release changelog from commits between two tags via AI Core
+export const useGenerateChangelog = () => {
+  return useMutation({
+    mutationFn: (request: GenerateChangelogRequest): Promise<GenerateChangelogResponse> =>
+      apiClient.post<GenerateChangelogResponse>('/ai-core/generate/changelog', request),
+  });
+};
+
+// Batch inference job types - offline processing of many prompts
+export interface CreateInferenceJobRequest {
+  deploymentId: string;
+  prompts: string[];
+  max_tokens?: number;
+  temperature?: number;
+  preset_id?: string;
+}
+
+export interface InferenceJob {
+  id: string;
+  status: 'PENDING' | 'RUNNING' | 'COMPLETED' | 'FAILED' | 'EXPIRED';
+  total: number;
+  completed: number;
+  failed: number;
+  createdAt: string;
+  /** Results are deleted after this time */
+  expiresAt: string;
+}
+
+export interface InferenceJobResult {
+  index: number;
+  prompt: string;
+  response?: string;
+  error?: string;
+}
+
+export interface InferenceJobResultsResponse {
+  job: InferenceJob;
+  results: InferenceJobResult[];
+}
+
+// Submit a batch of prompts for offline processing
+export const useCreateInferenceJob = () => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (request: CreateInferenceJobRequest): Promise<InferenceJob> =>
+      apiClient.post<InferenceJob>('/ai-core/jobs', request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['inference-jobs'] });
+    },
+  });
+};
+
+// Poll a batch job's progress until it completes
+export const useInferenceJob = (jobId: string | null) => {
+  return useQuery({
+    queryKey: ['inference-jobs', jobId],
+    queryFn: (): Promise<InferenceJob> =>
+      apiClient.get<InferenceJob>(`/ai-core/jobs/${jobId}`),
+    enabled: !!jobId,
+    refetchInterval: (query) => {
+      const status = query.state.data?.status;
+      return status === 'PENDING' || status === 'RUNNING' ? 5000 : false;
+    },
+  });
+};
+
+// Retrieve results of a completed batch job
+export const useInferenceJobResults = (jobId: string | null, enabled = true) => {
+  return useQuery({
+    queryKey: ['inference-jobs', jobId, 'results'],
+    queryFn: (): Promise<InferenceJobResultsResponse> =>
+      apiClient.get<InferenceJobResultsResponse>(`/ai-core/jobs/${jobId}/results`),
+    enabled: !!jobId && enabled,
+  });
+};
+
+// Model routing types - logical aliases mapped to weighted deployments
+export interface ModelRouteTarget {
+  deployment_id: string;
+  /** Traffic weight relative to the other targets of the alias */
+  weight: number;
+}
+
+export interface ModelRoutingRule {
+  /** Logical model alias, e.g. 'default-chat' */
+  alias: string;
+  targets: ModelRouteTarget[];
+  /** Deployment used when all weighted targets fail with 5xx/timeout */
+  fallback_deployment_id?: string;
+}
+
+export interface ModelRoutingRulesResponse {
+  rules: ModelRoutingRule[];
+}
+
+// List model routing rules (admin only)
+export const useModelRoutingRules = () => {
+  return useQuery({
+    queryKey: ['model-routing-rules'],
+    queryFn: (): Promise<ModelRoutingRulesResponse> =>
+      apiClient.get<ModelRoutingRulesResponse>('/ai-core/routing-rules'),
+    staleTime: 5 * 60 * 1000, // 5 minutes
+  });
+};
+
+// Create or replace a routing rule for an alias (admin only)
+export const useUpdateModelRoutingRule = () => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (rule: ModelRoutingRule): Promise<ModelRoutingRule> =>
+      apiClient.put<ModelRoutingRule>(`/ai-core/routing-rules/${rule.alias}`, rule),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['model-routing-rules'] });
+    },
+  });
+};
+
+// Inference logging types - admin visibility into AI usage with privacy controls
+export interface InferenceLogEntry {
+  id: string;
+  user_id: string;
+  deployment_id: string;
+  model: string;
+  /** 'hash' when org policy stores prompt hashes only, 'full' for full content */
+  content_mode: 'hash' | 'full';
+  prompt_hash?: string;
+  prompt?: string;
+  response?: string;
+  prompt_tokens: number;
+  completion_tokens: number;
+  created_at: string;
+}
+
+export interface InferenceLogsResponse {
+  count: number;
+  logs: InferenceLogEntry[];
+}
+
+export interface InferenceLogsParams {
+  user_id?: string;
+  deployment_id?: string;
+  from?: string;
+  to?: string;
+  top?: number;
+  skip?: number;
+}
+
+export interface InferenceLogSettings {
+  enabled: boolean;
+  content_mode: 'hash' | 'full';
+  retention_days: number;
+}
+
+// Query inference logs (admin only)
+export const useInferenceLogs = (params?: InferenceLogsParams) => {
+  return useQuery({
+    queryKey: ['inference-logs', params],
+    queryFn: (): Promise<InferenceLogsResponse> =>
+      apiClient.get<InferenceLogsResponse>('/ai-core/inference-logs', {
+        params: params as Record<string, string | number | boolean | undefined>,
+      }),
+  });
+};
+
+// Read inference logging settings (admin only)
+export const useInferenceLogSettings = () => {
+  return useQuery({
+    queryKey: ['inference-log-settings'],
+    queryFn: (): Promise<InferenceLogSettings> =>
+      apiClient.get<InferenceLogSettings>('/ai-core/inference-logs/settings'),
+  });
+};
+
+// Update inference logging settings (admin only)
+export const useUpdateInferenceLogSettings = () => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (settings: InferenceLogSettings): Promise<InferenceLogSettings> =>
+      apiClient.put<InferenceLogSettings>('/ai-core/inference-logs/settings', settings),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['inference-log-settings'] });
+    },
+  });
+};
+
+// System prompt policy types - org-level guardrails prepended to every request
+export interface SystemPromptPolicy {
+  /** Compliance preamble injected ahead of any client-provided system prompt */
+  preamble: string;
+  enabled: boolean;
+  /** Per-team overrides keyed by team name */
+  team_overrides?: Record<string, string>;
+  updated_at?: string;
+  updated_by?: string;
+}
+
+// Read the org's system prompt policy (admin only)
+export const useSystemPromptPolicy = () => {
+  return useQuery({
+    queryKey: ['system-prompt-policy'],
+    queryFn: (): Promise<SystemPromptPolicy> =>
+      apiClient.get<SystemPromptPolicy>('/ai-core/prompt-policy'),
+    staleTime: 15 * 60 * 1000, // 15 minutes
+  });
+};
+
+// Update the org's system prompt policy (admin only, change is audit logged)
+export const useUpdateSystemPromptPolicy = () => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: (policy: SystemPromptPolicy): Promise<SystemPromptPolicy> =>
+      apiClient.put<SystemPromptPolicy>('/ai-core/prompt-policy', policy),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['system-prompt-policy'] });
+    },
+  });
+};
+
+// Conversation sharing types
+export interface ShareConversationRequest {
+  conversationId: string;
+  /** private: owner only, team: readable by the owner's team, link: anyone with the token */
+  visibility: 'private' | 'team' | 'link';
+  /** Allow team members to append messages instead of read-only access */
+  collaborative?: boolean;
+}
+
+export interface ShareConversationResponse {
+  conversation_id: string;
+  visibility: 'private' | 'team' | 'link';
+  collaborative: boolean;
+  /** Share-link token, present when visibility is 'link' */
+  share_token?: string;
+}
+
+export interface SharedConversationMessage {
+  role: 'system' | 'user' | 'assistant';
+  content: string;
+  created_at: string;
+}
+
+export interface SharedConversationResponse {
+  id: string;
+  title: string;
+  model: string;
+  messages: SharedConversationMessage[];
+  owner_name: string;
+  created_at: string;
+  updated_at: string;
+}
+
+// Update a conversation's share setting
+export const useShareConversation = () => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: ({ conversationId, ...body }: ShareConversationRequest): Promise<ShareConversationResponse> =>
+      apiClient.put<ShareConversationResponse>(`/ai-core/conversations/${conversationId}/share`, body),
+    onSuccess: (data) => {
+      queryClient.invalidateQueries({ queryKey: ['shared-conversation', data.conversation_id] });
+    },
+  });
+};
+
+// Read a conversation shared via team visibility or a share-link token
+export const useSharedConversation = (idOrToken: string | null) => {
+  return useQuery({
+    queryKey: ['shared-conversation', idOrToken],
+    queryFn: (): Promise<SharedConversationResponse> =>
+      apiClient.get<SharedConversationResponse>(`/ai-core/conversations/shared/${idOrToken}`),
+    enabled: !!idOrToken,
+  });
+};
+
+// Conversation export types
+export interface ExportConversationRequest {
+  conversationId: string;
+  format: 'markdown' | 'json';
+  /** Run the server-side redaction pass before export */
+  redact?: boolean;
+}
+
+// Export a stored conversation (model info and timestamps included) for sharing
+export const useExportConversation = () => {
+  return useMutation({
+    mutationFn: ({ conversationId, format, redact }: ExportConversationRequest): Promise<Blob> =>
+      apiClient.getBinary(`/ai-core/conversations/${conversationId}/export`, {
+        params: { format, redact },
+      }),
+  });
+};
+
 // File upload types
 export interface UploadedFile {
   url: string; // Base64 data URL
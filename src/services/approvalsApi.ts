@@ -0,0 +1,59 @@
+import { apiClient } from './ApiClient';
+
+export type ApprovalStatus = 'pending' | 'approved' | 'rejected' | 'expired' | 'executed';
+
+/**
+ * An approval request created when a user triggers a configured sensitive
+ * action (recursive delete, production scaffold, restricted team join)
+ */
+export interface ApprovalRequest {
+  id: string;
+  action: string; // e.g. 'github.delete_folder'
+  description: string;
+  requested_by: string;
+  approvers: string[];
+  status: ApprovalStatus;
+  payload: Record<string, unknown>; // Action parameters, executed on approval
+  created_at: string;
+  decided_at?: string;
+  decided_by?: string;
+  decision_comment?: string;
+}
+
+export interface ApprovalRequestsResponse {
+  requests: ApprovalRequest[];
+}
+
+/**
+ * List approval requests; `role=approver` shows requests awaiting the
+ * current user's decision, `role=requester` shows their own
+ */
+export async function fetchApprovalRequests(
+  role: 'approver' | 'requester',
+  status?: ApprovalStatus
+): Promise<ApprovalRequestsResponse> {
+  return apiClient.get<ApprovalRequestsResponse>('/approvals', {
+    params: { role, status },
+  });
+}
+
+/**
+ * Approve a pending request; the backend executes the action on approval
+ */
+export async function approveRequest(requestId: string, comment?: string): Promise<ApprovalRequest> {
+  return apiClient.post<ApprovalRequest>(`/approvals/${requestId}/approve`, { comment });
+}
+
+/**
+ * Reject a pending request
+ */
+export async function rejectRequest(requestId: string, comment?: string): Promise<ApprovalRequest> {
+  return apiClient.post<ApprovalRequest>(`/approvals/${requestId}/reject`, { comment });
+}
+
+/**
+ * Withdraw one's own pending request
+ */
+export async function withdrawRequest(requestId: string): Promise<void> {
+  return apiClient.delete<void>(`/approvals/${requestId}`);
+}
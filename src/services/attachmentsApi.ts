@@ -0,0 +1,113 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A stored attachment (draft asset, scaffolder artifact, docs build output,
+ * AI file upload) kept in object storage behind signed URLs
+ */
+export interface Attachment {
+  id: string;
+  file_name: string;
+  content_type: string;
+  size_bytes: number;
+  context: 'draft' | 'scaffolder' | 'docs_build' | 'ai_upload';
+  team_id?: string;
+  uploaded_by: string;
+  created_at: string;
+  expires_at?: string; // Set when a lifecycle policy applies
+}
+
+export interface AttachmentsResponse {
+  attachments: Attachment[];
+}
+
+/**
+ * Signed upload target: the client PUTs the file bytes directly to
+ * upload_url, then the attachment becomes visible once storage confirms
+ */
+export interface SignedUploadResponse {
+  attachment: Attachment;
+  upload_url: string;
+  upload_headers: Record<string, string>;
+}
+
+export interface CreateAttachmentRequest {
+  file_name: string;
+  content_type: string;
+  size_bytes: number;
+  context: Attachment['context'];
+  team_id?: string;
+}
+
+/**
+ * Per-team storage quota usage
+ */
+export interface StorageQuota {
+  team_id: string;
+  used_bytes: number;
+  quota_bytes: number;
+}
+
+/**
+ * List attachments for a context (and optionally a team)
+ */
+export async function fetchAttachments(
+  context?: Attachment['context'],
+  teamId?: string
+): Promise<AttachmentsResponse> {
+  return apiClient.get<AttachmentsResponse>('/attachments', {
+    params: { context, team_id: teamId },
+  });
+}
+
+/**
+ * Request a signed upload URL for a new attachment
+ */
+export async function createAttachment(
+  request: CreateAttachmentRequest
+): Promise<SignedUploadResponse> {
+  return apiClient.post<SignedUploadResponse>('/attachments', request);
+}
+
+/**
+ * Get a short-lived signed download URL for an attachment
+ */
+export async function fetchAttachmentDownloadUrl(
+  attachmentId: string
+): Promise<{ download_url: string; expires_at: string }> {
+  return apiClient.get<{ download_url: string; expires_at: string }>(
+    `/attachments/${attachmentId}/download-url`
+  );
+}
+
+/**
+ * Delete an attachment
+ */
+export async function deleteAttachment(attachmentId: string): Promise<void> {
+  return apiClient.delete<void>(`/attachments/${attachmentId}`);
+}
+
+/**
+ * Fetch a team's storage quota usage
+ */
+export async function fetchStorageQuota(teamId: string): Promise<StorageQuota> {
+  return apiClient.get<StorageQuota>(`/teams/${teamId}/storage-quota`);
+}
+
+/**
+ * Upload file bytes to a signed URL returned by createAttachment
+ */
+export async function uploadToSignedUrl(
+  uploadUrl: string,
+  uploadHeaders: Record<string, string>,
+  file: Blob
+): Promise<void> {
+  const response = await fetch(uploadUrl, {
+    method: 'PUT',
+    headers: uploadHeaders,
+    body: file,
+  });
+
+  if (!response.ok) {
+    throw new Error(`Attachment upload failed with status ${response.status}`);
+  }
+}
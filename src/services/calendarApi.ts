@@ -0,0 +1,64 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * One shift in a team's on-call rotation, aggregated from PagerDuty and
+ * manually entered events
+ */
+export interface OnCallShift {
+  user_id: string;
+  user_name: string;
+  starts_at: string; // ISO 8601
+  ends_at: string; // ISO 8601
+  source: 'pagerduty' | 'manual';
+}
+
+/**
+ * A release calendar event
+ */
+export interface CalendarEvent {
+  id: string;
+  title: string;
+  starts_at: string;
+  ends_at: string;
+  kind: 'release' | 'freeze' | 'maintenance' | 'other';
+  description?: string;
+}
+
+export interface TeamCalendarResponse {
+  team_id: string;
+  oncall: OnCallShift[];
+  events: CalendarEvent[];
+}
+
+/**
+ * Response from the iCal token endpoint; the feed URL embeds a signed token
+ * so calendar clients can subscribe without portal authentication
+ */
+export interface ICalFeedResponse {
+  feed_url: string;
+  expires_at: string;
+}
+
+/**
+ * Fetch a team's on-call rotation and release calendar
+ *
+ * @param teamId - Team UUID
+ * @param from - Optional range start (ISO 8601)
+ * @param to - Optional range end (ISO 8601)
+ */
+export async function fetchTeamCalendar(
+  teamId: string,
+  from?: string,
+  to?: string
+): Promise<TeamCalendarResponse> {
+  return apiClient.get<TeamCalendarResponse>(`/teams/${teamId}/calendar`, {
+    params: { from, to },
+  });
+}
+
+/**
+ * Create a signed iCal feed URL for a team's calendar
+ */
+export async function createICalFeed(teamId: string): Promise<ICalFeedResponse> {
+  return apiClient.post<ICalFeedResponse>(`/teams/${teamId}/calendar/ical-token`);
+}
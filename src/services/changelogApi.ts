@@ -0,0 +1,54 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A portal changelog entry shown in the what's-new popover
+ */
+export interface ChangelogEntry {
+  id: string;
+  title: string;
+  body: string; // Markdown
+  category: 'feature' | 'improvement' | 'fix' | 'announcement';
+  published_at: string;
+  seen: boolean; // Per-user marker
+}
+
+export interface ChangelogResponse {
+  entries: ChangelogEntry[];
+  unseen_count: number;
+}
+
+export interface CreateChangelogEntryRequest {
+  title: string;
+  body: string;
+  category: ChangelogEntry['category'];
+}
+
+/**
+ * Fetch changelog entries with the current user's unseen markers
+ */
+export async function fetchChangelog(limit?: number): Promise<ChangelogResponse> {
+  return apiClient.get<ChangelogResponse>('/changelog', { params: { limit } });
+}
+
+/**
+ * Mark all changelog entries as seen for the current user
+ */
+export async function markChangelogSeen(): Promise<void> {
+  return apiClient.post<void>('/changelog/seen');
+}
+
+/**
+ * Create a changelog entry (admin only)
+ */
+export async function createChangelogEntry(
+  request: CreateChangelogEntryRequest
+): Promise<ChangelogEntry> {
+  return apiClient.post<ChangelogEntry>('/changelog', request);
+}
+
+/**
+ * Delete a changelog entry (admin only)
+ */
+export async function deleteChangelogEntry(entryId: string): Promise<void> {
+  return apiClient.delete<void>(`/changelog/${entryId}`);
+}
@@ -0,0 +1,56 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A parameter expected by a command, described by a JSON-schema-like shape
+ */
+export interface CommandParameter {
+  name: string;
+  type: 'string' | 'number' | 'boolean';
+  description?: string;
+  required: boolean;
+  enum?: string[];
+}
+
+/**
+ * An action the current user is allowed to run from the command palette
+ * The backend applies RBAC, so unauthorized actions never appear here.
+ */
+export interface Command {
+  id: string; // e.g., 'create-link', 'open-pr', 'start-chat'
+  title: string;
+  description?: string;
+  category?: string;
+  parameters: CommandParameter[];
+}
+
+export interface CommandsResponse {
+  commands: Command[];
+}
+
+export interface ExecuteCommandRequest {
+  parameters?: Record<string, string | number | boolean>;
+}
+
+export interface ExecuteCommandResponse {
+  success: boolean;
+  message?: string;
+  /** Optional URL to navigate to after execution (e.g., the created PR) */
+  redirect_url?: string;
+}
+
+/**
+ * Fetch the commands available to the current user
+ */
+export async function fetchCommands(): Promise<CommandsResponse> {
+  return apiClient.get<CommandsResponse>('/commands');
+}
+
+/**
+ * Execute a command with the given parameters
+ */
+export async function executeCommand(
+  commandId: string,
+  request?: ExecuteCommandRequest
+): Promise<ExecuteCommandResponse> {
+  return apiClient.post<ExecuteCommandResponse>(`/commands/${commandId}/execute`, request);
+}
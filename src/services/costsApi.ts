@@ -0,0 +1,70 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * One month of attributed cost for an entity
+ */
+export interface CostMonth {
+  month: string; // 'YYYY-MM'
+  amount: number;
+  currency: string;
+}
+
+/**
+ * Cost attributed to a catalog component or team
+ */
+export interface CostEntry {
+  entity_id: string;
+  entity_type: 'component' | 'team';
+  entity_name: string;
+  current_month: number;
+  previous_month: number;
+  currency: string;
+  trend: CostMonth[];
+}
+
+export interface CostsResponse {
+  entries: CostEntry[];
+  provider: string; // Which cost provider produced the data
+  updated_at: string;
+}
+
+/**
+ * A detected cost anomaly (sudden spike against the entity's baseline)
+ */
+export interface CostAnomaly {
+  entity_id: string;
+  entity_name: string;
+  month: string;
+  expected: number;
+  actual: number;
+  deviation_percent: number;
+}
+
+export interface CostAnomaliesResponse {
+  anomalies: CostAnomaly[];
+}
+
+export interface CostsParams {
+  entity_type?: 'component' | 'team';
+  entity_id?: string;
+  /** Number of trailing months to include in trends (default 12) */
+  months?: number;
+}
+
+/**
+ * Fetch attributed costs with monthly trends
+ */
+export async function fetchCosts(params?: CostsParams): Promise<CostsResponse> {
+  return apiClient.get<CostsResponse>('/costs', {
+    params: params as Record<string, string | number | boolean | undefined>,
+  });
+}
+
+/**
+ * Fetch detected cost anomalies
+ */
+export async function fetchCostAnomalies(params?: CostsParams): Promise<CostAnomaliesResponse> {
+  return apiClient.get<CostAnomaliesResponse>('/costs/anomalies', {
+    params: params as Record<string, string | number | boolean | undefined>,
+  });
+}
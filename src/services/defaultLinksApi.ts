@@ -0,0 +1,45 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A link template inside a team's default set
+ */
+export interface DefaultLinkEntry {
+  title: string;
+  url: string;
+  category_name: string;
+  description?: string;
+}
+
+/**
+ * A team's default link/category set, copied to new members on first login
+ */
+export interface TeamDefaultLinks {
+  team_id: string;
+  links: DefaultLinkEntry[];
+  updated_at?: string;
+  updated_by?: string;
+}
+
+/**
+ * Fetch a team's default link set
+ */
+export async function fetchTeamDefaultLinks(teamId: string): Promise<TeamDefaultLinks> {
+  return apiClient.get<TeamDefaultLinks>(`/teams/${teamId}/default-links`);
+}
+
+/**
+ * Replace a team's default link set (team leads only)
+ */
+export async function updateTeamDefaultLinks(
+  teamId: string,
+  links: DefaultLinkEntry[]
+): Promise<TeamDefaultLinks> {
+  return apiClient.put<TeamDefaultLinks>(`/teams/${teamId}/default-links`, { links });
+}
+
+/**
+ * Opt the current user out of (or back into) default link provisioning
+ */
+export async function setDefaultLinksOptOut(optOut: boolean): Promise<void> {
+  return apiClient.put<void>('/users/me/default-links/opt-out', { opt_out: optOut });
+}
@@ -0,0 +1,155 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A normalized dependency collected from manifests (go.mod, package.json,
+ * pom.xml) across catalog repos
+ */
+export interface DependencyUsage {
+  package: string;
+  version: string;
+  ecosystem: 'go' | 'npm' | 'maven';
+  license?: string;
+  component_id: string;
+  component_name: string;
+  repo: string;
+  manifest_path: string;
+}
+
+export interface DependencySearchParams {
+  package?: string; // Substring match, e.g. 'log4j'
+  version?: string; // Semver range, e.g. '2.x'
+  ecosystem?: string;
+  license?: string;
+  page?: number;
+  page_size?: number;
+}
+
+export interface DependencySearchResponse {
+  usages: DependencyUsage[];
+  total: number;
+}
+
+/**
+ * Per-license usage counts for the license report
+ */
+export interface LicenseReportEntry {
+  license: string;
+  package_count: number;
+  component_count: number;
+}
+
+export interface LicenseReportResponse {
+  licenses: LicenseReportEntry[];
+  generated_at: string;
+}
+
+/**
+ * Search the org-wide dependency inventory ("who uses log4j 2.x?")
+ */
+export async function searchDependencies(
+  params?: DependencySearchParams
+): Promise<DependencySearchResponse> {
+  return apiClient.get<DependencySearchResponse>('/dependencies', {
+    params: params as unknown as Record<string, string | number | boolean | undefined>,
+  });
+}
+
+/**
+ * Fetch the org-wide license report
+ */
+export async function fetchLicenseReport(): Promise<LicenseReportResponse> {
+  return apiClient.get<LicenseReportResponse>('/dependencies/licenses');
+}
+
+export type SbomFormat = 'spdx' | 'cyclonedx';
+
+/**
+ * A stored SBOM version for a catalog component
+ */
+export interface SbomVersion {
+  id: string;
+  component_id: string;
+  version: string; // Component version the SBOM describes
+  format: SbomFormat;
+  source: 'generated' | 'uploaded';
+  created_at: string;
+}
+
+export interface SbomVersionsResponse {
+  sboms: SbomVersion[];
+}
+
+/**
+ * Difference between two SBOM versions of a component
+ */
+export interface SbomDiff {
+  added: DependencyUsage[];
+  removed: DependencyUsage[];
+  version_changed: Array<{ package: string; from: string; to: string }>;
+}
+
+/**
+ * List stored SBOM versions for a component
+ */
+export async function fetchSbomVersions(componentId: string): Promise<SbomVersionsResponse> {
+  return apiClient.get<SbomVersionsResponse>(`/components/${componentId}/sboms`);
+}
+
+/**
+ * Download one SBOM document
+ */
+export async function fetchSbomDocument(componentId: string, sbomId: string): Promise<Blob> {
+  return apiClient.getBinary(`/components/${componentId}/sboms/${sbomId}`);
+}
+
+export type VulnerabilitySeverity = 'critical' | 'high' | 'medium' | 'low';
+
+/**
+ * A vulnerability correlated from advisory feeds (OSV/GitHub advisories)
+ * against the component's dependency inventory
+ */
+export interface ComponentVulnerability {
+  advisory_id: string; // e.g. 'GHSA-xxxx' or 'CVE-2021-44228'
+  package: string;
+  affected_version: string;
+  fixed_version?: string;
+  severity: VulnerabilitySeverity;
+  summary: string;
+  url: string;
+  published_at: string;
+}
+
+export interface ComponentVulnerabilitiesResponse {
+  component_id: string;
+  vulnerabilities: ComponentVulnerability[];
+  scanned_at: string;
+}
+
+/**
+ * Fetch correlated vulnerabilities for a component
+ *
+ * @param componentId - Catalog component UUID
+ * @param minSeverity - Optional severity floor (e.g. 'high')
+ */
+export async function fetchComponentVulnerabilities(
+  componentId: string,
+  minSeverity?: VulnerabilitySeverity
+): Promise<ComponentVulnerabilitiesResponse> {
+  return apiClient.get<ComponentVulnerabilitiesResponse>(
+    `/components/${componentId}/vulnerabilities`,
+    { params: { min_severity: minSeverity } }
+  );
+}
+
+/**
+ * Diff two SBOM versions of a component
+ */
+export async function fetchSbomDiff(
+  componentId: string,
+  fromSbomId: string,
+  toSbomId: string
+): Promise<SbomDiff> {
+  return apiClient.get<SbomDiff>(`/components/${componentId}/sboms/diff`, {
+    params: { from: fromSbomId, to: toSbomId },
+  });
+}
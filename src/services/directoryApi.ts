@@ -0,0 +1,117 @@
+import { apiClient } from './ApiClient';
+import type { PaginatedResponse } from '@/types/api';
+
+/**
+ * A user entry in the people directory
+ */
+export interface DirectoryUser {
+  user_id: string;
+  name: string;
+  email: string;
+  title?: string;
+  team_names: string[];
+  organization_name?: string;
+  manager_id?: string; // Present when SCIM provides manager chains
+  avatar_url?: string;
+}
+
+/**
+ * Per-user activity summary shown on directory profiles
+ */
+export interface DirectoryActivitySummary {
+  user_id: string;
+  pull_requests_30d: number;
+  reviews_30d: number;
+  last_active_at?: string;
+}
+
+export interface DirectoryParams {
+  search?: string;
+  team_id?: string;
+  group_id?: string;
+  organization_id?: string;
+  page?: number;
+  page_size?: number;
+}
+
+export interface DirectoryResponse extends PaginatedResponse<DirectoryUser> {
+  users: DirectoryUser[];
+}
+
+/**
+ * Browse the people directory by team/group/organization with search
+ */
+export async function fetchDirectory(params?: DirectoryParams): Promise<DirectoryResponse> {
+  return apiClient.get<DirectoryResponse>('/directory', {
+    params: params as unknown as Record<string, string | number | boolean | undefined>,
+  });
+}
+
+/**
+ * Fetch the manager chain for a user (empty if SCIM does not provide one)
+ */
+export async function fetchManagerChain(userId: string): Promise<{ chain: DirectoryUser[] }> {
+  return apiClient.get<{ chain: DirectoryUser[] }>(`/directory/${userId}/managers`);
+}
+
+/**
+ * Fetch the activity summary for a directory profile
+ */
+export async function fetchDirectoryActivity(userId: string): Promise<DirectoryActivitySummary> {
+  return apiClient.get<DirectoryActivitySummary>(`/directory/${userId}/activity`);
+}
+
+/**
+ * A skill tag on a user profile, self-declared or inferred from GitHub
+ * language stats
+ */
+export interface SkillTag {
+  skill: string;
+  source: 'declared' | 'inferred';
+}
+
+export interface UserSkillsResponse {
+  skills: SkillTag[];
+  expert_finder_opt_out: boolean;
+}
+
+/**
+ * A ranked expert candidate for a skill
+ */
+export interface ExpertMatch {
+  user: DirectoryUser;
+  skill: string;
+  score: number;
+  evidence: string[]; // e.g. "declared", "42% of commits in Go"
+}
+
+export interface ExpertsResponse {
+  experts: ExpertMatch[];
+}
+
+/**
+ * Fetch the current user's skill tags
+ */
+export async function fetchMySkills(): Promise<UserSkillsResponse> {
+  return apiClient.get<UserSkillsResponse>('/users/me/skills');
+}
+
+/**
+ * Update the current user's declared skills and expert-finder opt-out
+ */
+export async function updateMySkills(
+  skills: string[],
+  expertFinderOptOut?: boolean
+): Promise<UserSkillsResponse> {
+  return apiClient.put<UserSkillsResponse>('/users/me/skills', {
+    skills,
+    expert_finder_opt_out: expertFinderOptOut,
+  });
+}
+
+/**
+ * Find likely experts for a skill, respecting privacy opt-outs
+ */
+export async function fetchExperts(skill: string): Promise<ExpertsResponse> {
+  return apiClient.get<ExpertsResponse>('/experts', { params: { skill } });
+}
@@ -0,0 +1,82 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * One autosaved draft edit to a repository file
+ */
+export interface DraftFile {
+  path: string;
+  content: string;
+  base_sha?: string; // SHA of the file version the edit started from
+  updated_at: string;
+}
+
+/**
+ * A user's draft workspace for a repository: edits to multiple files
+ * accumulated server-side before publishing as one branch + PR
+ */
+export interface DraftWorkspace {
+  id: string;
+  provider: string;
+  owner: string;
+  repo: string;
+  files: DraftFile[];
+  updated_at: string;
+}
+
+export interface PublishDraftsResponse {
+  branch: string;
+  pr_number: number;
+  pr_url: string;
+}
+
+/**
+ * Fetch the current user's draft workspace for a repository
+ */
+export async function fetchDraftWorkspace(
+  provider: string,
+  owner: string,
+  repo: string
+): Promise<DraftWorkspace> {
+  return apiClient.get<DraftWorkspace>(`/github/${provider}/repos/${owner}/${repo}/drafts`);
+}
+
+/**
+ * Autosave a draft edit for one file; called from the editor on a debounce
+ */
+export async function saveDraftFile(
+  provider: string,
+  owner: string,
+  repo: string,
+  file: Omit<DraftFile, 'updated_at'>
+): Promise<DraftFile> {
+  return apiClient.put<DraftFile>(`/github/${provider}/repos/${owner}/${repo}/drafts/file`, file);
+}
+
+/**
+ * Discard the draft edit for one file
+ */
+export async function discardDraftFile(
+  provider: string,
+  owner: string,
+  repo: string,
+  path: string
+): Promise<void> {
+  return apiClient.delete<void>(`/github/${provider}/repos/${owner}/${repo}/drafts/file`, { path });
+}
+
+/**
+ * Publish all drafts in the workspace as a single branch + PR via the
+ * multi-file commit API
+ */
+export async function publishDrafts(
+  provider: string,
+  owner: string,
+  repo: string,
+  prTitle: string,
+  prBody?: string
+): Promise<PublishDraftsResponse> {
+  return apiClient.post<PublishDraftsResponse>(
+    `/github/${provider}/repos/${owner}/${repo}/drafts/publish`,
+    { title: prTitle, body: prBody }
+  );
+}
@@ -0,0 +1,70 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * An advisory edit lock on a repository file. Locks expire after their TTL
+ * unless refreshed, so a closed tab never blocks a file permanently.
+ */
+export interface EditLock {
+  path: string;
+  held_by: string;
+  held_by_name: string;
+  acquired_at: string;
+  expires_at: string;
+  mine: boolean;
+}
+
+/**
+ * Presence of other users currently viewing/editing files in the repo
+ */
+export interface EditPresence {
+  path: string;
+  user_id: string;
+  user_name: string;
+  last_seen_at: string;
+}
+
+export interface EditPresenceResponse {
+  locks: EditLock[];
+  presence: EditPresence[];
+}
+
+/**
+ * Fetch locks and presence for a repository; the editor polls this while open
+ */
+export async function fetchEditPresence(
+  provider: string,
+  owner: string,
+  repo: string
+): Promise<EditPresenceResponse> {
+  return apiClient.get<EditPresenceResponse>(`/github/${provider}/repos/${owner}/${repo}/locks`);
+}
+
+/**
+ * Acquire (or refresh) an advisory lock on a file. Refreshing extends the TTL.
+ *
+ * @param force - Take over a lock held by someone else
+ */
+export async function acquireEditLock(
+  provider: string,
+  owner: string,
+  repo: string,
+  path: string,
+  force = false
+): Promise<EditLock> {
+  return apiClient.post<EditLock>(`/github/${provider}/repos/${owner}/${repo}/locks`, {
+    path,
+    force,
+  });
+}
+
+/**
+ * Release an advisory lock on a file
+ */
+export async function releaseEditLock(
+  provider: string,
+  owner: string,
+  repo: string,
+  path: string
+): Promise<void> {
+  return apiClient.delete<void>(`/github/${provider}/repos/${owner}/${repo}/locks`, { path });
+}
@@ -1,5 +1,6 @@
-import { ClosePullRequestParams, ClosePullRequestPayload, ClosePullRequestResponse, GitHubPRQueryParams, GitHubPullRequestsResponse } from '@/types/developer-portal';
+import { ClosePullRequestParams, ClosePullRequestPayload, ClosePullRequestResponse, GitHubPRQueryParams, GitHubPullRequestsResponse, MergePullRequestParams, MergePullRequestResponse, PullRequestSummaryResponse, SummarizePullRequestParams } from '@/types/developer-portal';
 import { apiClient } from './ApiClient';
+import { getNewBackendUrl } from '@/constants/developer-portal';
 import { GitHubContributionsResponse, GitHubAveragePRTimeResponse, GitHubHeatmapResponse, GitHubPRReviewCommentsResponse } from '@/types/api';
 
 
@@ -49,9 +50,35 @@ export async function fetchGitHubContributions(): Promise<GitHubContributionsRes
   return apiClient.get<GitHubContributionsResponse>('/github/contributions');
 }
 
-export async function fetchGitHubAveragePRTime(period?: string): Promise<GitHubAveragePRTimeResponse> {
+/**
+ * Options controlling how metric time series are bucketed.
+ * Without a timezone the backend falls back to fixed UTC week boundaries.
+ */
+export interface MetricSeriesOptions {
+  /** IANA timezone name used for bucket boundaries (e.g. 'Europe/Berlin') */
+  timezone?: string;
+  /** First day of the week, 'monday' or 'sunday' (default: monday) */
+  weekStart?: 'monday' | 'sunday';
+  /**
+   * Bucket size for the series (default: week). The backend rejects
+   * combinations producing overly long series (e.g. 365d at day grain).
+   */
+  granularity?: 'day' | 'week' | 'month';
+}
+
+export async function fetchGitHubAveragePRTime(
+  period?: string,
+  teamId?: string,
+  seriesOptions?: MetricSeriesOptions
+): Promise<GitHubAveragePRTimeResponse> {
   return apiClient.get<GitHubAveragePRTimeResponse>('/github/average-pr-time', {
-    params: period ? { period } : undefined,
+    params: {
+      period,
+      team_id: teamId,
+      timezone: seriesOptions?.timezone,
+      week_start: seriesOptions?.weekStart,
+      granularity: seriesOptions?.granularity,
+    },
   });
 }
 
@@ -59,13 +86,311 @@ export async function fetchGitHubHeatmap(): Promise<GitHubHeatmapResponse> {
   return apiClient.get<GitHubHeatmapResponse>('/github/githubtools/heatmap');
 }
 
-export async function fetchGitHubPRReviewComments(period?: string): Promise<GitHubPRReviewCommentsResponse> {
+export async function fetchGitHubPRReviewComments(
+  period?: string,
+  teamId?: string,
+  seriesOptions?: MetricSeriesOptions
+): Promise<GitHubPRReviewCommentsResponse> {
   return apiClient.get<GitHubPRReviewCommentsResponse>('/github/pr-review-comments', {
-    params: period ? { period } : undefined,
+    params: {
+      period,
+      team_id: teamId,
+      timezone: seriesOptions?.timezone,
+      week_start: seriesOptions?.weekStart,
+      granularity: seriesOptions?.granularity,
+    },
+  });
+}
+
+/**
+ * Metrics endpoints that support CSV export via the format query parameter
+ */
+export type GitHubMetricEndpoint = 'average-pr-time' | 'pr-review-comments' | 'contributions';
+
+/**
+ * Download a metrics endpoint response as CSV
+ *
+ * The backend returns text/csv when format=csv is requested, which is
+ * fetched as a Blob so callers can trigger a browser download.
+ *
+ * @param metric - Which metrics endpoint to export
+ * @param period - Optional period filter (e.g., '365d')
+ * @param teamId - Optional team filter (leads only)
+ */
+export async function exportGitHubMetricCsv(
+  metric: GitHubMetricEndpoint,
+  period?: string,
+  teamId?: string
+): Promise<Blob> {
+  return apiClient.getBinary(`/github/${metric}`, {
+    params: { format: 'csv', period, team_id: teamId },
+    headers: { Accept: 'text/csv' },
+  });
+}
+
+/**
+ * An anomaly flagged by the rolling z-score detector over metric snapshots
+ */
+export interface MetricAnomaly {
+  metric: string; // e.g. 'average_pr_merge_time'
+  team_id?: string;
+  detected_at: string;
+  baseline_value: number;
+  observed_value: number;
+  z_score: number;
+  direction: 'regression' | 'improvement';
+  message: string;
+}
+
+export interface MetricAnomaliesResponse {
+  anomalies: MetricAnomaly[];
+}
+
+/**
+ * Fetch recently flagged metric anomalies (merge time doubled, review
+ * comments dropped to zero, ...)
+ *
+ * @param teamId - Optional team filter
+ */
+export async function fetchMetricAnomalies(teamId?: string): Promise<MetricAnomaliesResponse> {
+  return apiClient.get<MetricAnomaliesResponse>('/metrics/anomalies', {
+    params: { team_id: teamId },
+  });
+}
+
+/**
+ * A GitHub Discussion, fetched through the backend's GraphQL client
+ */
+export interface GitHubDiscussion {
+  id: string;
+  number: number;
+  title: string;
+  body: string;
+  category: string;
+  author: string;
+  answered: boolean;
+  comment_count: number;
+  created_at: string;
+  url: string;
+}
+
+export interface GitHubDiscussionsResponse {
+  discussions: GitHubDiscussion[];
+  total: number;
+}
+
+export interface CreateDiscussionRequest {
+  provider: string;
+  owner: string;
+  repo: string;
+  title: string;
+  body: string;
+  category: string;
+}
+
+/**
+ * List discussions for a repository
+ */
+export async function fetchDiscussions(
+  provider: string,
+  owner: string,
+  repo: string,
+  unansweredOnly?: boolean
+): Promise<GitHubDiscussionsResponse> {
+  return apiClient.get<GitHubDiscussionsResponse>(
+    `/github/${provider}/repos/${owner}/${repo}/discussions`,
+    { params: { unanswered: unansweredOnly } }
+  );
+}
+
+/**
+ * Create a discussion in a repository
+ */
+export async function createDiscussion(
+  request: CreateDiscussionRequest
+): Promise<GitHubDiscussion> {
+  const { provider, owner, repo, ...body } = request;
+  return apiClient.post<GitHubDiscussion>(
+    `/github/${provider}/repos/${owner}/${repo}/discussions`,
+    body
+  );
+}
+
+/**
+ * Surface unanswered discussions across repos a team owns
+ */
+export async function fetchTeamUnansweredDiscussions(
+  teamId: string
+): Promise<GitHubDiscussionsResponse> {
+  return apiClient.get<GitHubDiscussionsResponse>('/github/discussions/unanswered', {
+    params: { team_id: teamId },
   });
 }
 
+/**
+ * One audited repository setting compared against org policy
+ */
+export interface RepoSettingsAuditCheck {
+  setting: string; // e.g. 'branch_protection.required_reviews'
+  expected: string;
+  actual: string;
+  compliant: boolean;
+}
+
+export interface RepoSettingsAuditResponse {
+  owner: string;
+  repo: string;
+  compliant: boolean;
+  checks: RepoSettingsAuditCheck[];
+  audited_at: string;
+}
+
+/**
+ * Audit a repository's settings (branch protection, required reviews,
+ * secret scanning, visibility) against org policy
+ */
+export async function fetchRepoSettingsAudit(
+  provider: string,
+  owner: string,
+  repo: string
+): Promise<RepoSettingsAuditResponse> {
+  return apiClient.get<RepoSettingsAuditResponse>(
+    `/github/${provider}/repos/${owner}/${repo}/settings-audit`
+  );
+}
+
+/**
+ * A destructive operation performed through the portal that can still be
+ * undone within the retention window
+ */
+export interface UndoableOperation {
+  id: string;
+  kind: 'file_delete' | 'folder_delete';
+  owner: string;
+  repo: string;
+  path: string;
+  commit_sha: string; // Commit that performed the deletion
+  performed_at: string;
+  undo_expires_at: string;
+}
+
+export interface UndoableOperationsResponse {
+  operations: UndoableOperation[];
+}
+
+/**
+ * List the current user's recent destructive operations that can be undone
+ */
+export async function fetchUndoableOperations(
+  provider: string
+): Promise<UndoableOperationsResponse> {
+  return apiClient.get<UndoableOperationsResponse>(`/github/${provider}/undoable-operations`);
+}
+
+/**
+ * Undo a deletion by restoring the recorded content via a revert commit
+ */
+export async function undoOperation(
+  provider: string,
+  operationId: string
+): Promise<{ revert_commit_sha: string }> {
+  return apiClient.post<{ revert_commit_sha: string }>(
+    `/github/${provider}/undoable-operations/${operationId}/undo`
+  );
+}
+
 export async function closePullRequest(params: ClosePullRequestParams): Promise<{ message: string }> {
   const { prNumber, ...body } = params;
   return apiClient.patch<{ message: string }>(`/github/githubtools/pull-requests/close/${prNumber}`, body);
+}
+
+export async function mergePullRequest(params: MergePullRequestParams): Promise<MergePullRequestResponse> {
+  const { prNumber, ...body } = params;
+  return apiClient.put<MergePullRequestResponse>(`/github/githubtools/pull-requests/merge/${prNumber}`, body);
+}
+
+export interface StreamRepositoryFileParams {
+  provider: string;
+  owner: string;
+  repo: string;
+  /** Path of the file within the repository */
+  path: string;
+  ref?: string;
+  /** Optional byte range (e.g., 'bytes=0-1048575') for partial downloads */
+  range?: string;
+}
+
+export interface RepositoryFileStream {
+  /** Body stream; consume incrementally instead of buffering the whole file */
+  stream: ReadableStream<Uint8Array>;
+  /** 200 for full responses, 206 when a Range header was honored */
+  status: number;
+  /** Size of this response in bytes, when the backend reports it */
+  contentLength?: number;
+  /** Content-Range header for 206 responses (e.g., 'bytes 0-1048575/314572800') */
+  contentRange?: string;
+}
+
+/**
+ * Download a large repository file (release asset, LFS object) through the
+ * backend streaming proxy. The body is returned as a ReadableStream so
+ * multi-hundred-MB artifacts are never buffered in memory; callers that need
+ * chunked downloads drive ranged requests themselves by passing a Range
+ * header per call and checking contentRange on the 206 responses.
+ */
+export async function streamRepositoryFile(
+  params: StreamRepositoryFileParams
+): Promise<RepositoryFileStream> {
+  const { provider, owner, repo, path, ref, range } = params;
+  const token = await apiClient.getToken();
+  const backendUrl = getNewBackendUrl();
+
+  // Encode each path segment; raw paths with spaces, '#' or '?' would
+  // otherwise change the request URL
+  const encodedPath = path.split('/').map(encodeURIComponent).join('/');
+  const url = new URL(
+    `${backendUrl}/api/v1/github/${provider}/repos/${owner}/${repo}/stream/${encodedPath}`
+  );
+  if (ref) {
+    url.searchParams.append('ref', ref);
+  }
+
+  const response = await fetch(url.toString(), {
+    method: 'GET',
+    headers: {
+      'Authorization': `Bearer ${token}`,
+      ...(range ? { Range: range } : {}),
+    },
+    credentials: 'include',
+  });
+
+  if (!response.ok) {
+    const error = await response.json().catch(() => ({}));
+    throw new Error(error.message || error.error || 'Failed to stream repository file');
+  }
+
+  if (!response.body) {
+    throw new Error('Response body is null');
+  }
+
+  const contentLength = response.headers.get('Content-Length');
+
+  return {
+    stream: response.body,
+    status: response.status,
+    contentLength: contentLength ? Number(contentLength) : undefined,
+    contentRange: response.headers.get('Content-Range') || undefined,
+  };
+}
+
+/**
+ * Generate an AI summary (purpose, risk, test coverage) for a pull request
+ * The backend fetches the diff and files and runs them through AI Core.
+ */
+export async function summarizePullRequest(params: SummarizePullRequestParams): Promise<PullRequestSummaryResponse> {
+  const { provider, owner, repo, prNumber, model } = params;
+  return apiClient.post<PullRequestSummaryResponse>(
+    `/github/${provider}/repos/${owner}/${repo}/pulls/${prNumber}/summarize`,
+    model ? { model } : undefined
+  );
 }
\ No newline at end of file
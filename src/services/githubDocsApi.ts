@@ -1,4 +1,5 @@
 import { ApiClient } from "./ApiClient";
+import { getNewBackendUrl } from "@/constants/developer-portal";
 
 const apiClient = new ApiClient();
 
@@ -40,6 +41,22 @@ export interface GitHubContent {
   type: "file" | "dir" | "symlink" | "submodule";
   content?: string; // Base64 encoded for files
   encoding?: string;
+  /** Symlink target path, set when type is 'symlink' */
+  target?: string;
+  /** Submodule repo coordinates resolved by the backend, set when type is 'submodule' */
+  submodule?: {
+    git_url: string;
+    owner: string;
+    repo: string;
+    sha: string;
+  };
+  /** Set when the entry is a Git LFS pointer, as flagged by the backend */
+  lfs?: {
+    oid: string;
+    size: number;
+    /** True when the backend resolved the pointer to the actual object */
+    resolved: boolean;
+  };
   _links: {
     self: string;
     git: string;
@@ -205,7 +222,17 @@ export async function buildDocTree(
         type: 'file',
         url: item.html_url,
       });
+    } else if (item.type === 'symlink' && (item.target?.endsWith('.md') ?? false)) {
+      // Symlinked markdown files resolve to their target content when fetched
+      tree.push({
+        name: item.name,
+        path: relativePath,
+        type: 'file',
+        url: item.html_url,
+      });
     }
+    // Submodule entries are intentionally skipped - they are separate repos,
+    // not empty files
   }
 
   // Sort: directories first, then files, both alphabetically
@@ -255,7 +282,17 @@ export async function buildDocTreeLazy(
         type: 'file',
         url: item.html_url,
       });
+    } else if (item.type === 'symlink' && (item.target?.endsWith('.md') ?? false)) {
+      // Symlinked markdown files resolve to their target content when fetched
+      tree.push({
+        name: item.name,
+        path: relativePath,
+        type: 'file',
+        url: item.html_url,
+      });
     }
+    // Submodule entries are intentionally skipped - they are separate repos,
+    // not empty files
   }
 
   // Sort: directories first, then files, both alphabetically
@@ -371,6 +408,70 @@ export async function createGitHubFolder(
   });
 }
 
+/**
+ * A pending file change scheduled for future publishing
+ */
+export interface ScheduledChange {
+  id: string;
+  path: string;
+  message: string;
+  publish_at: string; // ISO 8601
+  created_by: string;
+  status: 'pending' | 'published' | 'failed' | 'cancelled';
+}
+
+/**
+ * Schedule a file create/update for a future time. The scheduler executes
+ * the change with conflict re-validation at publish time.
+ * @param filePath - Path to the file (relative to docsPath)
+ * @param content - File content
+ * @param commitMessage - Commit message
+ * @param publishAt - When to publish (ISO 8601)
+ * @param config - Docs configuration
+ */
+export async function scheduleGitHubFileChange(
+  filePath: string,
+  content: string,
+  commitMessage: string,
+  publishAt: string,
+  config: typeof DOCS_CONFIG = DOCS_CONFIG
+): Promise<ScheduledChange> {
+  const fullPath = `${config.docsPath}/${filePath}`;
+  const url = `/github/${config.provider}/repos/${config.owner}/${config.repo}/scheduled-changes`;
+
+  return apiClient.post<ScheduledChange>(url, {
+    path: fullPath,
+    message: commitMessage,
+    content,
+    branch: config.branch,
+    publish_at: publishAt,
+  });
+}
+
+/**
+ * List pending scheduled changes for the docs repository
+ * @param config - Docs configuration
+ */
+export async function fetchScheduledChanges(
+  config: typeof DOCS_CONFIG = DOCS_CONFIG
+): Promise<{ changes: ScheduledChange[] }> {
+  const url = `/github/${config.provider}/repos/${config.owner}/${config.repo}/scheduled-changes`;
+  return apiClient.get<{ changes: ScheduledChange[] }>(url);
+}
+
+/**
+ * Cancel a scheduled change before it is published
+ * @param changeId - Scheduled change ID
+ * @param config - Docs configuration
+ */
+export async function cancelScheduledChange(
+  changeId: string,
+  config: typeof DOCS_CONFIG = DOCS_CONFIG
+): Promise<void> {
+  const url = `/github/${config.provider}/repos/${config.owner}/${config.repo}/scheduled-changes/${changeId}`;
+  return apiClient.delete<void>(url);
+}
+
 /**
  * Delete a file from GitHub repository
  * @param filePath - Path to the file (relative to docsPath)
@@ -418,6 +519,121 @@ export async function deleteGitHubFolder(
   });
 }
 
+/**
+ * Fetch a file that is stored in Git LFS, asking the backend to resolve the
+ * pointer and stream the actual object. Used for LFS-stored doc images which
+ * would otherwise render as broken pointer files.
+ *
+ * @param path - Path to the file (relative to docsPath)
+ * @param config - Docs configuration
+ */
+export async function fetchLfsObject(
+  path: string,
+  config: typeof DOCS_CONFIG = DOCS_CONFIG
+): Promise<Blob> {
+  const fullPath = path.startsWith(config.docsPath) ? path : `${config.docsPath}/${path}`;
+  const url = `/github/${config.provider}/repos/${config.owner}/${config.repo}/contents/${fullPath}`;
+
+  return apiClient.getBinary(url, {
+    params: { ref: config.branch, lfs: 'resolve' },
+  });
+}
+
+export interface DocsAnswerCitation {
+  path: string; // File path of the markdown chunk the answer is based on
+  excerpt?: string;
+}
+
+export interface DocsAnswer {
+  answer: string;
+  citations: DocsAnswerCitation[];
+}
+
+/**
+ * Ask a question about a repository's documentation
+ *
+ * The backend retrieves relevant markdown chunks from the repo's RAG index
+ * and streams the answer via SSE. Citations arrive as a final event once
+ * the answer is complete.
+ *
+ * @param question - The user's question
+ * @param onChunk - Called with the accumulated answer as chunks arrive
+ * @param config - Docs configuration (provider/owner/repo)
+ */
+export async function askDocsQuestion(
+  question: string,
+  onChunk?: (answer: string) => void,
+  config: typeof DOCS_CONFIG = DOCS_CONFIG
+): Promise<DocsAnswer> {
+  const token = await apiClient.getToken();
+  const backendUrl = getNewBackendUrl();
+
+  const url = `${backendUrl}/api/v1/github/${config.provider}/repos/${config.owner}/${config.repo}/ask`;
+
+  const response = await fetch(url, {
+    method: 'POST',
+    headers: {
+      'Content-Type': 'application/json',
+      'Authorization': `Bearer ${token}`,
+    },
+    body: JSON.stringify({ question }),
+    credentials: 'include',
+  });
+
+  if (!response.ok) {
+    const error = await response.json().catch(() => ({}));
+    throw new Error(error.message || error.error || 'Failed to ask docs question');
+  }
+
+  if (!response.body) {
+    throw new Error('Response body is null');
+  }
+
+  // Read the SSE stream and accumulate the answer
+  const reader = response.body.getReader();
+  const decoder = new TextDecoder();
+  let answer = '';
+  let citations: DocsAnswerCitation[] = [];
+
+  try {
+    while (true) {
+      const { done, value } = await reader.read();
+      if (done) break;
+
+      const chunk = decoder.decode(value, { stream: true });
+      const lines = chunk.split('\n');
+
+      for (const line of lines) {
+        if (!line.startsWith('data: ')) continue;
+
+        const data = line.substring(6).trim();
+        if (data === '[DONE]') {
+          return { answer, citations };
+        }
+
+        try {
+          const parsed = JSON.parse(data);
+          if (parsed.delta) {
+            answer += parsed.delta;
+            if (onChunk) {
+              onChunk(answer);
+            }
+          }
+          if (Array.isArray(parsed.citations)) {
+            citations = parsed.citations;
+          }
+        } catch (e) {
+          console.warn('Failed to parse SSE data:', e);
+        }
+      }
+    }
+  } finally {
+    reader.releaseLock();
+  }
+
+  return { answer, citations };
+}
+
 /**
  * Check if a folder is empty (only contains .gitkeep)
  * @param folderPath - Path to the folder (relative to docsPath)
@@ -0,0 +1,134 @@
+import { apiClient } from './ApiClient';
+
+export type IncidentSeverity = 'sev1' | 'sev2' | 'sev3' | 'sev4';
+export type IncidentStatus = 'open' | 'mitigated' | 'resolved';
+
+/**
+ * A timeline entry recorded during an incident
+ */
+export interface IncidentTimelineEntry {
+  at: string; // ISO 8601
+  text: string;
+  author_id: string;
+}
+
+export interface Incident {
+  id: string;
+  title: string;
+  severity: IncidentSeverity;
+  status: IncidentStatus;
+  affected_component_ids: string[];
+  timeline: IncidentTimelineEntry[];
+  links: string[]; // Related tickets, dashboards, chat threads
+  started_at: string;
+  resolved_at?: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface IncidentsResponse {
+  incidents: Incident[];
+  total: number;
+}
+
+export interface CreateIncidentRequest {
+  title: string;
+  severity: IncidentSeverity;
+  affected_component_ids?: string[];
+  links?: string[];
+  started_at?: string;
+}
+
+export interface UpdateIncidentRequest {
+  title?: string;
+  severity?: IncidentSeverity;
+  status?: IncidentStatus;
+  affected_component_ids?: string[];
+  links?: string[];
+  resolved_at?: string;
+}
+
+/**
+ * An action item from a postmortem, tied to a team
+ */
+export interface PostmortemActionItem {
+  id: string;
+  description: string;
+  team_id: string;
+  done: boolean;
+  due_date?: string;
+}
+
+export interface Postmortem {
+  id: string;
+  incident_id: string;
+  summary: string;
+  root_cause: string;
+  action_items: PostmortemActionItem[];
+  created_at: string;
+  updated_at: string;
+}
+
+export interface IncidentsParams {
+  status?: IncidentStatus;
+  severity?: IncidentSeverity;
+  component_id?: string;
+  page?: number;
+  page_size?: number;
+}
+
+/**
+ * Fetch incidents with optional filters
+ */
+export async function fetchIncidents(params?: IncidentsParams): Promise<IncidentsResponse> {
+  return apiClient.get<IncidentsResponse>('/incidents', {
+    params: params as Record<string, string | number | boolean | undefined>,
+  });
+}
+
+/**
+ * Fetch a single incident
+ */
+export async function fetchIncident(incidentId: string): Promise<Incident> {
+  return apiClient.get<Incident>(`/incidents/${incidentId}`);
+}
+
+/**
+ * Record a new incident
+ */
+export async function createIncident(request: CreateIncidentRequest): Promise<Incident> {
+  return apiClient.post<Incident>('/incidents', request);
+}
+
+/**
+ * Update an incident (status changes feed MTTR metrics)
+ */
+export async function updateIncident(incidentId: string, request: UpdateIncidentRequest): Promise<Incident> {
+  return apiClient.patch<Incident>(`/incidents/${incidentId}`, request);
+}
+
+/**
+ * Append a timeline entry to an incident
+ */
+export async function addIncidentTimelineEntry(incidentId: string, text: string): Promise<Incident> {
+  return apiClient.post<Incident>(`/incidents/${incidentId}/timeline`, { text });
+}
+
+/**
+ * Fetch the postmortem for an incident
+ */
+export async function fetchPostmortem(incidentId: string): Promise<Postmortem> {
+  return apiClient.get<Postmortem>(`/incidents/${incidentId}/postmortem`);
+}
+
+/**
+ * Create or replace the postmortem for an incident
+ */
+export async function upsertPostmortem(
+  incidentId: string,
+  postmortem: Pick<Postmortem, 'summary' | 'root_cause'> & {
+    action_items?: Array<Omit<PostmortemActionItem, 'id'>>;
+  }
+): Promise<Postmortem> {
+  return apiClient.put<Postmortem>(`/incidents/${incidentId}/postmortem`, postmortem);
+}
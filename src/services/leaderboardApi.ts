@@ -0,0 +1,65 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A single leaderboard row
+ * Users who opted out are excluded server-side and never appear here
+ */
+export interface LeaderboardEntry {
+  user_id: string;
+  first_name: string;
+  last_name: string;
+  prs_merged: number;
+  reviews_given: number;
+  docs_contributions: number;
+  rank: number;
+}
+
+/**
+ * Leaderboard response from /leaderboard
+ */
+export interface LeaderboardResponse {
+  entries: LeaderboardEntry[];
+  scope: 'org' | 'team';
+  period: string;
+  from: string;
+  to: string;
+}
+
+/**
+ * Leaderboard query parameters
+ */
+export interface LeaderboardParams {
+  scope?: 'org' | 'team';
+  team_id?: string;
+  period?: string; // e.g., '30d', '90d'
+}
+
+/**
+ * Current user's leaderboard opt-out state, stored in profile preferences
+ */
+export interface LeaderboardOptOutResponse {
+  opted_out: boolean;
+}
+
+/**
+ * Fetch the org or team leaderboard for a period
+ */
+export async function fetchLeaderboard(params?: LeaderboardParams): Promise<LeaderboardResponse> {
+  return apiClient.get<LeaderboardResponse>('/leaderboard', {
+    params: params as Record<string, string | number | boolean | undefined>,
+  });
+}
+
+/**
+ * Get the current user's leaderboard opt-out state
+ */
+export async function fetchLeaderboardOptOut(): Promise<LeaderboardOptOutResponse> {
+  return apiClient.get<LeaderboardOptOutResponse>('/leaderboard/opt-out');
+}
+
+/**
+ * Update the current user's leaderboard opt-out state
+ */
+export async function updateLeaderboardOptOut(optedOut: boolean): Promise<LeaderboardOptOutResponse> {
+  return apiClient.put<LeaderboardOptOutResponse>('/leaderboard/opt-out', { opted_out: optedOut });
+}
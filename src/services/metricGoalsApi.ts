@@ -0,0 +1,46 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A target value a team sets for an engineering metric
+ */
+export interface MetricGoal {
+  metric: string; // e.g. 'average_pr_merge_time_hours'
+  target_value: number;
+  comparison: 'lt' | 'gt'; // Whether lower or higher is better
+  set_at: string;
+  set_by: string;
+}
+
+/**
+ * Attainment of one goal over the requested window
+ */
+export interface MetricGoalAttainment {
+  metric: string;
+  target_value: number;
+  current_value: number;
+  attained: boolean;
+  trend: 'improving' | 'flat' | 'worsening';
+}
+
+export interface TeamMetricGoalsResponse {
+  team_id: string;
+  goals: MetricGoal[];
+  attainment: MetricGoalAttainment[];
+}
+
+/**
+ * Fetch a team's metric goals with current attainment
+ */
+export async function fetchTeamMetricGoals(teamId: string): Promise<TeamMetricGoalsResponse> {
+  return apiClient.get<TeamMetricGoalsResponse>(`/teams/${teamId}/metric-goals`);
+}
+
+/**
+ * Replace a team's metric goals (team leads only)
+ */
+export async function updateTeamMetricGoals(
+  teamId: string,
+  goals: Pick<MetricGoal, 'metric' | 'target_value' | 'comparison'>[]
+): Promise<TeamMetricGoalsResponse> {
+  return apiClient.put<TeamMetricGoalsResponse>(`/teams/${teamId}/metric-goals`, { goals });
+}
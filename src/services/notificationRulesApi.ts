@@ -0,0 +1,92 @@
+import { apiClient } from './ApiClient';
+
+export type NotificationRuleEvent =
+  | 'pr_approved'
+  | 'pr_changes_requested'
+  | 'pr_merged'
+  | 'pr_stale'
+  | 'review_requested'
+  | 'pr_comment';
+
+export type NotificationChannel = 'portal' | 'email' | 'slack';
+
+/**
+ * A condition evaluated against webhook event payloads,
+ * e.g. { field: 'age_days', op: 'gt', value: 5 }
+ */
+export interface NotificationRuleCondition {
+  field: string;
+  op: 'eq' | 'ne' | 'gt' | 'lt' | 'contains';
+  value: string | number | boolean;
+}
+
+/**
+ * A user- or team-scoped notification rule evaluated by the rules engine
+ */
+export interface NotificationRule {
+  id: string;
+  name: string;
+  event: NotificationRuleEvent;
+  conditions: NotificationRuleCondition[];
+  channels: NotificationChannel[];
+  team_id?: string; // Absent for personal rules
+  enabled: boolean;
+  created_at: string;
+}
+
+export interface NotificationRulesResponse {
+  rules: NotificationRule[];
+}
+
+export interface UpsertNotificationRuleRequest {
+  name: string;
+  event: NotificationRuleEvent;
+  conditions?: NotificationRuleCondition[];
+  channels: NotificationChannel[];
+  team_id?: string;
+  enabled?: boolean;
+}
+
+/**
+ * List notification rules for the current user (and their teams)
+ */
+export async function fetchNotificationRules(): Promise<NotificationRulesResponse> {
+  return apiClient.get<NotificationRulesResponse>('/notification-rules');
+}
+
+/**
+ * Create a notification rule
+ */
+export async function createNotificationRule(
+  request: UpsertNotificationRuleRequest
+): Promise<NotificationRule> {
+  return apiClient.post<NotificationRule>('/notification-rules', request);
+}
+
+/**
+ * Update a notification rule
+ */
+export async function updateNotificationRule(
+  ruleId: string,
+  request: UpsertNotificationRuleRequest
+): Promise<NotificationRule> {
+  return apiClient.put<NotificationRule>(`/notification-rules/${ruleId}`, request);
+}
+
+/**
+ * Delete a notification rule
+ */
+export async function deleteNotificationRule(ruleId: string): Promise<void> {
+  return apiClient.delete<void>(`/notification-rules/${ruleId}`);
+}
+
+/**
+ * Test-fire a rule against a sample event without delivering to channels
+ */
+export async function testFireNotificationRule(
+  ruleId: string
+): Promise<{ matched: boolean; rendered_message: string }> {
+  return apiClient.post<{ matched: boolean; rendered_message: string }>(
+    `/notification-rules/${ruleId}/test`
+  );
+}
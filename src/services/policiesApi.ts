@@ -0,0 +1,80 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * An org policy evaluated by the backend policy engine against catalog
+ * entities, repo settings, and API requests
+ */
+export interface Policy {
+  id: string;
+  name: string;
+  description?: string;
+  language: 'rego' | 'cel';
+  source: string;
+  enabled: boolean;
+  updated_at: string;
+  updated_by: string;
+}
+
+export interface PoliciesResponse {
+  policies: Policy[];
+}
+
+export interface UpsertPolicyRequest {
+  name: string;
+  description?: string;
+  language: 'rego' | 'cel';
+  source: string;
+  enabled?: boolean;
+}
+
+/**
+ * A logged policy decision, for audit and debugging
+ */
+export interface PolicyDecision {
+  policy_id: string;
+  policy_name: string;
+  subject: string; // User or service account the decision applied to
+  resource: string;
+  allowed: boolean;
+  reason?: string;
+  decided_at: string;
+}
+
+export interface PolicyDecisionsResponse {
+  decisions: PolicyDecision[];
+}
+
+/**
+ * List org policies (admin only)
+ */
+export async function fetchPolicies(): Promise<PoliciesResponse> {
+  return apiClient.get<PoliciesResponse>('/admin/policies');
+}
+
+/**
+ * Create a policy (admin only)
+ */
+export async function createPolicy(request: UpsertPolicyRequest): Promise<Policy> {
+  return apiClient.post<Policy>('/admin/policies', request);
+}
+
+/**
+ * Update a policy (admin only)
+ */
+export async function updatePolicy(policyId: string, request: UpsertPolicyRequest): Promise<Policy> {
+  return apiClient.put<Policy>(`/admin/policies/${policyId}`, request);
+}
+
+/**
+ * Delete a policy (admin only)
+ */
+export async function deletePolicy(policyId: string): Promise<void> {
+  return apiClient.delete<void>(`/admin/policies/${policyId}`);
+}
+
+/**
+ * Fetch recent policy decisions for a policy (admin only)
+ */
+export async function fetchPolicyDecisions(policyId: string): Promise<PolicyDecisionsResponse> {
+  return apiClient.get<PolicyDecisionsResponse>(`/admin/policies/${policyId}/decisions`);
+}
@@ -0,0 +1,74 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * Known preference namespaces
+ * The backend validates the schema of these; unknown namespaces are
+ * stored as opaque JSON subject to size limits.
+ */
+export type PreferenceNamespace = 'dashboard' | 'provider' | 'theme' | string;
+
+/**
+ * A single namespace's preferences - arbitrary JSON per user
+ */
+export type PreferenceValues = Record<string, unknown>;
+
+/**
+ * Response from GET /users/me/preferences
+ * Keyed by namespace, e.g. { dashboard: {...}, theme: {...} }
+ */
+export type UserPreferencesResponse = Record<string, PreferenceValues>;
+
+/**
+ * Fetch all preferences for the current user
+ */
+export async function fetchUserPreferences(): Promise<UserPreferencesResponse> {
+  return apiClient.get<UserPreferencesResponse>('/users/me/preferences');
+}
+
+/**
+ * Fetch a single preference namespace for the current user
+ *
+ * @param namespace - Namespace to fetch (e.g., 'dashboard')
+ */
+export async function fetchUserPreferenceNamespace(namespace: PreferenceNamespace): Promise<PreferenceValues> {
+  return apiClient.get<PreferenceValues>(`/users/me/preferences/${namespace}`);
+}
+
+/**
+ * Replace a preference namespace for the current user
+ *
+ * @param namespace - Namespace to update (e.g., 'dashboard')
+ * @param values - New values for the namespace
+ */
+export async function updateUserPreferences(
+  namespace: PreferenceNamespace,
+  values: PreferenceValues
+): Promise<PreferenceValues> {
+  return apiClient.put<PreferenceValues>(`/users/me/preferences/${namespace}`, values);
+}
+
+/**
+ * Per-user working hours, used by notifications (deferred pushes) and by
+ * metrics (business-hours merge/review times)
+ */
+export interface WorkingHours {
+  timezone: string; // IANA name, e.g. 'Europe/Berlin'
+  work_days: number[]; // ISO weekday numbers, 1 = Monday
+  start_hour: number; // 0-23, local to timezone
+  end_hour: number;
+  defer_notifications: boolean; // Hold non-urgent pushes outside working hours
+}
+
+/**
+ * Fetch the current user's working hours configuration
+ */
+export async function fetchWorkingHours(): Promise<WorkingHours> {
+  return apiClient.get<WorkingHours>('/users/me/working-hours');
+}
+
+/**
+ * Replace the current user's working hours configuration
+ */
+export async function updateWorkingHours(workingHours: WorkingHours): Promise<WorkingHours> {
+  return apiClient.put<WorkingHours>('/users/me/working-hours', workingHours);
+}
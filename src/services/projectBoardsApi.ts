@@ -0,0 +1,52 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A GitHub Projects v2 item, surfaced in the "my work" view
+ */
+export interface ProjectBoardItem {
+  id: string;
+  project_title: string;
+  title: string;
+  status: string;
+  iteration?: string;
+  assignee?: string;
+  content_type: 'issue' | 'pull_request' | 'draft';
+  content_url?: string;
+  updated_at: string;
+}
+
+export interface ProjectBoardItemsResponse {
+  items: ProjectBoardItem[];
+}
+
+/**
+ * Fetch Projects v2 items assigned to the current user
+ */
+export async function fetchMyProjectItems(provider: string): Promise<ProjectBoardItemsResponse> {
+  return apiClient.get<ProjectBoardItemsResponse>(`/github/${provider}/projects/items/me`);
+}
+
+/**
+ * Fetch Projects v2 items for a team
+ */
+export async function fetchTeamProjectItems(
+  provider: string,
+  teamId: string
+): Promise<ProjectBoardItemsResponse> {
+  return apiClient.get<ProjectBoardItemsResponse>(`/github/${provider}/projects/items`, {
+    params: { team_id: teamId },
+  });
+}
+
+/**
+ * Move a project item to a different status column
+ */
+export async function moveProjectItem(
+  provider: string,
+  itemId: string,
+  status: string
+): Promise<ProjectBoardItem> {
+  return apiClient.patch<ProjectBoardItem>(`/github/${provider}/projects/items/${itemId}`, {
+    status,
+  });
+}
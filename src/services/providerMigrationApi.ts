@@ -0,0 +1,45 @@
+import { apiClient } from './ApiClient';
+
+export type MigrationPhase = 'pending' | 'dual_read' | 'cutover' | 'completed' | 'failed';
+
+/**
+ * Status of a provider-to-provider migration (e.g. githubwdf → githubtools)
+ */
+export interface ProviderMigration {
+  id: string;
+  source_provider: string;
+  target_provider: string;
+  phase: MigrationPhase;
+  users_total: number;
+  users_migrated: number;
+  tokens_migrated: number;
+  started_at?: string;
+  completed_at?: string;
+  errors: string[];
+}
+
+export interface ProviderMigrationsResponse {
+  migrations: ProviderMigration[];
+}
+
+export interface StartProviderMigrationRequest {
+  source_provider: string;
+  target_provider: string;
+  dual_read?: boolean; // Serve reads from both providers during transition
+}
+
+/**
+ * List provider migrations and their status reports (admin only)
+ */
+export async function fetchProviderMigrations(): Promise<ProviderMigrationsResponse> {
+  return apiClient.get<ProviderMigrationsResponse>('/admin/provider-migrations');
+}
+
+/**
+ * Start a provider migration (admin only)
+ */
+export async function startProviderMigration(
+  request: StartProviderMigrationRequest
+): Promise<ProviderMigration> {
+  return apiClient.post<ProviderMigration>('/admin/provider-migrations', request);
+}
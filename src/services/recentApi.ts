@@ -0,0 +1,57 @@
+import { apiClient } from './ApiClient';
+
+export type PortalResourceKind = 'repo' | 'doc' | 'component' | 'conversation' | 'link';
+
+/**
+ * A recently visited or favorited portal resource
+ */
+export interface PortalResourceRef {
+  kind: PortalResourceKind;
+  resource_id: string;
+  title: string;
+  url: string;
+  visited_at?: string;
+}
+
+export interface RecentResourcesResponse {
+  items: PortalResourceRef[];
+}
+
+export interface FavoritesResponse {
+  items: PortalResourceRef[];
+}
+
+/**
+ * Fetch the current user's recently accessed resources
+ */
+export async function fetchRecentResources(limit?: number): Promise<RecentResourcesResponse> {
+  return apiClient.get<RecentResourcesResponse>('/recent', { params: { limit } });
+}
+
+/**
+ * Record a resource visit; fire-and-forget from navigation handlers
+ */
+export async function recordResourceVisit(ref: Omit<PortalResourceRef, 'visited_at'>): Promise<void> {
+  return apiClient.post<void>('/recent', ref);
+}
+
+/**
+ * Fetch the current user's favorites
+ */
+export async function fetchFavorites(): Promise<FavoritesResponse> {
+  return apiClient.get<FavoritesResponse>('/favorites');
+}
+
+/**
+ * Pin a resource to favorites
+ */
+export async function addFavorite(ref: Omit<PortalResourceRef, 'visited_at'>): Promise<void> {
+  return apiClient.post<void>('/favorites', ref);
+}
+
+/**
+ * Remove a resource from favorites
+ */
+export async function removeFavorite(kind: PortalResourceKind, resourceId: string): Promise<void> {
+  return apiClient.delete<void>(`/favorites/${kind}/${resourceId}`);
+}
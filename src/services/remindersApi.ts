@@ -0,0 +1,46 @@
+import { apiClient } from './ApiClient';
+
+export type SnoozeTargetKind = 'pull_request' | 'notification' | 'review_request';
+
+/**
+ * A pending reminder created by snoozing a PR, notification, or review request
+ */
+export interface Reminder {
+  id: string;
+  target_kind: SnoozeTargetKind;
+  target_id: string;
+  target_title: string;
+  remind_at: string; // When the target re-surfaces via notifications
+  created_at: string;
+}
+
+export interface RemindersResponse {
+  reminders: Reminder[];
+}
+
+export interface SnoozeRequest {
+  target_kind: SnoozeTargetKind;
+  target_id: string;
+  remind_at: string; // ISO 8601
+}
+
+/**
+ * List the current user's pending reminders
+ */
+export async function fetchReminders(): Promise<RemindersResponse> {
+  return apiClient.get<RemindersResponse>('/reminders');
+}
+
+/**
+ * Snooze an item until the chosen time
+ */
+export async function snoozeItem(request: SnoozeRequest): Promise<Reminder> {
+  return apiClient.post<Reminder>('/reminders', request);
+}
+
+/**
+ * Cancel a pending reminder; the item re-surfaces immediately
+ */
+export async function cancelReminder(reminderId: string): Promise<void> {
+  return apiClient.delete<void>(`/reminders/${reminderId}`);
+}
@@ -0,0 +1,99 @@
+import { apiClient } from './ApiClient';
+
+export type RetentionDataType =
+  | 'audit_events'
+  | 'ai_logs'
+  | 'analytics'
+  | 'notifications';
+
+/**
+ * A retention policy for one data type, enforced by the scheduled purge job
+ */
+export interface RetentionPolicy {
+  data_type: RetentionDataType;
+  retention_days: number;
+  enabled: boolean;
+  last_purge_at?: string;
+  last_purge_deleted_rows?: number;
+}
+
+export interface RetentionPoliciesResponse {
+  policies: RetentionPolicy[];
+}
+
+/**
+ * Report from a purge run; with dry_run the rows are counted, not deleted
+ */
+export interface PurgeReport {
+  data_type: RetentionDataType;
+  dry_run: boolean;
+  affected_rows: number;
+  oldest_remaining?: string;
+}
+
+/**
+ * Fetch retention policies (admin only)
+ */
+export async function fetchRetentionPolicies(): Promise<RetentionPoliciesResponse> {
+  return apiClient.get<RetentionPoliciesResponse>('/admin/retention-policies');
+}
+
+/**
+ * Update the retention policy for a data type (admin only)
+ */
+export async function updateRetentionPolicy(
+  dataType: RetentionDataType,
+  retentionDays: number,
+  enabled: boolean
+): Promise<RetentionPolicy> {
+  return apiClient.put<RetentionPolicy>(`/admin/retention-policies/${dataType}`, {
+    retention_days: retentionDays,
+    enabled,
+  });
+}
+
+/**
+ * Trigger a purge run for a data type, optionally as a dry run (admin only)
+ */
+export async function runRetentionPurge(
+  dataType: RetentionDataType,
+  dryRun = true
+): Promise<PurgeReport> {
+  return apiClient.post<PurgeReport>(`/admin/retention-policies/${dataType}/purge`, {
+    dry_run: dryRun,
+  });
+}
+
+/**
+ * An archive exported to object storage before retention-based deletion
+ */
+export interface ArchiveEntry {
+  id: string;
+  data_type: RetentionDataType | 'metrics_snapshots' | 'usage';
+  format: 'parquet' | 'csv';
+  row_count: number;
+  size_bytes: number;
+  range_start: string;
+  range_end: string;
+  created_at: string;
+}
+
+export interface ArchivesResponse {
+  archives: ArchiveEntry[];
+}
+
+/**
+ * List cold-storage archives (admin only)
+ */
+export async function fetchArchives(dataType?: string): Promise<ArchivesResponse> {
+  return apiClient.get<ArchivesResponse>('/admin/archives', {
+    params: { data_type: dataType },
+  });
+}
+
+/**
+ * Restore an archive's rows into a queryable staging table (admin only)
+ */
+export async function restoreArchive(archiveId: string): Promise<{ staging_table: string }> {
+  return apiClient.post<{ staging_table: string }>(`/admin/archives/${archiveId}/restore`);
+}
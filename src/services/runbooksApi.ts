@@ -0,0 +1,81 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A registered runbook backed by markdown in a repo
+ */
+export interface Runbook {
+  id: string;
+  title: string;
+  /** Repo coordinates of the markdown source */
+  provider: string;
+  owner: string;
+  repo: string;
+  path: string;
+  /** Components and alert names this runbook applies to */
+  component_ids: string[];
+  alert_names: string[];
+  team_id: string;
+  last_reviewed_at?: string;
+  /** True when the runbook is past its review interval */
+  stale: boolean;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface RunbooksResponse {
+  runbooks: Runbook[];
+}
+
+export interface RunbooksParams {
+  search?: string;
+  component_id?: string;
+  alert_name?: string;
+  team_id?: string;
+}
+
+export interface RegisterRunbookRequest {
+  title: string;
+  provider: string;
+  owner: string;
+  repo: string;
+  path: string;
+  component_ids?: string[];
+  alert_names?: string[];
+}
+
+/**
+ * Search registered runbooks
+ */
+export async function fetchRunbooks(params?: RunbooksParams): Promise<RunbooksResponse> {
+  return apiClient.get<RunbooksResponse>('/runbooks', {
+    params: params as Record<string, string | number | boolean | undefined>,
+  });
+}
+
+/**
+ * Fetch a runbook's rendered markdown content via the content API
+ */
+export async function fetchRunbookContent(runbookId: string): Promise<{ content: string }> {
+  return apiClient.get<{ content: string }>(`/runbooks/${runbookId}/content`);
+}
+
+/**
+ * Register a new runbook for the caller's team
+ */
+export async function registerRunbook(request: RegisterRunbookRequest): Promise<Runbook> {
+  return apiClient.post<Runbook>('/runbooks', request);
+}
+
+/**
+ * Mark a runbook as reviewed, clearing its staleness warning
+ */
+export async function markRunbookReviewed(runbookId: string): Promise<Runbook> {
+  return apiClient.post<Runbook>(`/runbooks/${runbookId}/reviewed`);
+}
+
+/**
+ * Remove a runbook registration
+ */
+export async function deleteRunbook(runbookId: string): Promise<void> {
+  return apiClient.delete<void>(`/runbooks/${runbookId}`);
+}
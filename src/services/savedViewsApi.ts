@@ -0,0 +1,69 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * What kind of listing a saved view applies to
+ */
+export type SavedViewKind = 'pull-requests' | 'links';
+
+/**
+ * A named filter/sort combination persisted server-side
+ */
+export interface SavedView {
+  id: string;
+  name: string;
+  kind: SavedViewKind;
+  filters: Record<string, unknown>; // Filter/sort state as stored by the UI
+  shared_with_team: boolean;
+  owner_id: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface SavedViewsResponse {
+  views: SavedView[];
+}
+
+export interface CreateSavedViewRequest {
+  name: string;
+  kind: SavedViewKind;
+  filters: Record<string, unknown>;
+  shared_with_team?: boolean;
+}
+
+export interface UpdateSavedViewRequest {
+  name?: string;
+  filters?: Record<string, unknown>;
+  shared_with_team?: boolean;
+}
+
+/**
+ * Fetch saved views for the current user (includes team-shared views)
+ *
+ * @param kind - Optional filter by view kind
+ */
+export async function fetchSavedViews(kind?: SavedViewKind): Promise<SavedViewsResponse> {
+  return apiClient.get<SavedViewsResponse>('/views', {
+    params: kind ? { kind } : undefined,
+  });
+}
+
+/**
+ * Create a new saved view
+ */
+export async function createSavedView(request: CreateSavedViewRequest): Promise<SavedView> {
+  return apiClient.post<SavedView>('/views', request);
+}
+
+/**
+ * Update an existing saved view
+ */
+export async function updateSavedView(viewId: string, request: UpdateSavedViewRequest): Promise<SavedView> {
+  return apiClient.patch<SavedView>(`/views/${viewId}`, request);
+}
+
+/**
+ * Delete a saved view
+ */
+export async function deleteSavedView(viewId: string): Promise<void> {
+  return apiClient.delete<void>(`/views/${viewId}`);
+}
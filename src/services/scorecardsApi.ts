@@ -0,0 +1,64 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * Result of a single scorecard check against a component
+ */
+export interface ScorecardCheckResult {
+  check_id: string; // e.g., 'has-readme', 'has-codeowners', 'ci-green'
+  title: string;
+  passed: boolean;
+  /** Human-readable detail, e.g. 'CODEOWNERS file missing' */
+  detail?: string;
+}
+
+/**
+ * A component's scorecard evaluation
+ */
+export interface ComponentScorecard {
+  component_id: string;
+  component_name: string;
+  score: number; // 0-100
+  checks: ScorecardCheckResult[];
+  evaluated_at: string;
+}
+
+/**
+ * Per-team rollup of component scores
+ */
+export interface TeamScorecardRollup {
+  team_id: string;
+  team_name: string;
+  average_score: number;
+  component_count: number;
+  /** Pass rate per check across the team's components, keyed by check ID */
+  check_pass_rates: Record<string, number>;
+}
+
+export interface TeamScorecardsResponse {
+  rollups: TeamScorecardRollup[];
+}
+
+/**
+ * Fetch the scorecard for a single component
+ */
+export async function fetchComponentScorecard(componentId: string): Promise<ComponentScorecard> {
+  return apiClient.get<ComponentScorecard>(`/scorecards/components/${componentId}`);
+}
+
+/**
+ * Fetch scorecard rollups per team
+ *
+ * @param teamId - Optional filter to a single team
+ */
+export async function fetchTeamScorecards(teamId?: string): Promise<TeamScorecardsResponse> {
+  return apiClient.get<TeamScorecardsResponse>('/scorecards/teams', {
+    params: teamId ? { team_id: teamId } : undefined,
+  });
+}
+
+/**
+ * Trigger a re-evaluation of a component's scorecard
+ */
+export async function evaluateComponentScorecard(componentId: string): Promise<ComponentScorecard> {
+  return apiClient.post<ComponentScorecard>(`/scorecards/components/${componentId}/evaluate`);
+}
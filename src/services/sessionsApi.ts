@@ -0,0 +1,78 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * An active session (refresh token) for the current user
+ */
+export interface UserSession {
+  id: string;
+  created_at: string;
+  last_seen_at: string;
+  ip_address: string;
+  user_agent: string;
+  device?: string;
+  location?: string;
+  current: boolean;
+}
+
+export interface UserSessionsResponse {
+  sessions: UserSession[];
+}
+
+/**
+ * List the current user's active sessions with device/IP metadata
+ */
+export async function fetchMySessions(): Promise<UserSessionsResponse> {
+  return apiClient.get<UserSessionsResponse>('/users/me/sessions');
+}
+
+/**
+ * Revoke a single session; its refresh token stops validating immediately
+ */
+export async function revokeSession(sessionId: string): Promise<void> {
+  return apiClient.delete<void>(`/users/me/sessions/${sessionId}`);
+}
+
+/**
+ * Revoke all sessions except the current one
+ */
+export async function revokeAllSessions(): Promise<void> {
+  return apiClient.delete<void>('/users/me/sessions');
+}
+
+export type SecurityEventKind =
+  | 'failed_login'
+  | 'lockout'
+  | 'new_country'
+  | 'impossible_travel'
+  | 'new_device';
+
+/**
+ * An auth anomaly flagged for the current user by the brute-force /
+ * anomaly detection on the auth endpoints
+ */
+export interface SecurityEvent {
+  id: string;
+  kind: SecurityEventKind;
+  occurred_at: string;
+  ip_address: string;
+  location?: string;
+  acknowledged: boolean;
+}
+
+export interface SecurityEventsResponse {
+  events: SecurityEvent[];
+}
+
+/**
+ * List recent security events for the current user
+ */
+export async function fetchMySecurityEvents(): Promise<SecurityEventsResponse> {
+  return apiClient.get<SecurityEventsResponse>('/users/me/security-events');
+}
+
+/**
+ * Acknowledge a security event so it stops surfacing as a warning
+ */
+export async function acknowledgeSecurityEvent(eventId: string): Promise<SecurityEvent> {
+  return apiClient.post<SecurityEvent>(`/users/me/security-events/${eventId}/acknowledge`);
+}
@@ -0,0 +1,47 @@
+import { apiClient } from './ApiClient';
+
+export type ShareableResourceKind = 'doc' | 'conversation_export';
+
+/**
+ * A time-limited signed share link for a docs page or conversation export
+ */
+export interface ShareLink {
+  id: string;
+  resource_kind: ShareableResourceKind;
+  resource_id: string;
+  url: string; // Signed public URL, valid without portal auth
+  expires_at: string;
+  view_count: number;
+  created_at: string;
+}
+
+export interface ShareLinksResponse {
+  share_links: ShareLink[];
+}
+
+export interface CreateShareLinkRequest {
+  resource_kind: ShareableResourceKind;
+  resource_id: string;
+  expires_in_hours?: number; // Default: 72
+}
+
+/**
+ * List the current user's active share links
+ */
+export async function fetchShareLinks(): Promise<ShareLinksResponse> {
+  return apiClient.get<ShareLinksResponse>('/share-links');
+}
+
+/**
+ * Create a signed share link for a docs page or conversation export
+ */
+export async function createShareLink(request: CreateShareLinkRequest): Promise<ShareLink> {
+  return apiClient.post<ShareLink>('/share-links', request);
+}
+
+/**
+ * Revoke a share link; the signed URL stops resolving immediately
+ */
+export async function revokeShareLink(shareLinkId: string): Promise<void> {
+  return apiClient.delete<void>(`/share-links/${shareLinkId}`);
+}
@@ -0,0 +1,103 @@
+import { apiClient } from './ApiClient';
+
+export type SurveyQuestionType = 'nps' | 'rating' | 'text' | 'single_choice' | 'multi_choice';
+
+/**
+ * One question in a survey schema
+ */
+export interface SurveyQuestion {
+  id: string;
+  type: SurveyQuestionType;
+  prompt: string;
+  required: boolean;
+  options?: string[]; // For choice questions
+}
+
+/**
+ * An admin-managed survey (NPS, onboarding feedback, ...)
+ */
+export interface Survey {
+  id: string;
+  title: string;
+  description?: string;
+  questions: SurveyQuestion[];
+  target_team_ids?: string[]; // Empty means all users
+  anonymous: boolean;
+  active: boolean;
+  created_at: string;
+  closes_at?: string;
+}
+
+export interface SurveysResponse {
+  surveys: Survey[];
+}
+
+export interface CreateSurveyRequest {
+  title: string;
+  description?: string;
+  questions: Omit<SurveyQuestion, 'id'>[];
+  target_team_ids?: string[];
+  anonymous?: boolean;
+  closes_at?: string;
+}
+
+export interface SurveyResponseSubmission {
+  answers: Record<string, string | string[] | number>; // Keyed by question id
+}
+
+/**
+ * Aggregated results for one survey question
+ */
+export interface SurveyQuestionResults {
+  question_id: string;
+  response_count: number;
+  average?: number; // For nps/rating questions
+  distribution?: Record<string, number>; // For choice questions
+  answers?: string[]; // For text questions, omitted when anonymized
+}
+
+export interface SurveyResults {
+  survey_id: string;
+  total_responses: number;
+  questions: SurveyQuestionResults[];
+}
+
+/**
+ * List surveys; non-admins only see surveys targeted at them
+ */
+export async function fetchSurveys(activeOnly?: boolean): Promise<SurveysResponse> {
+  return apiClient.get<SurveysResponse>('/surveys', {
+    params: { active: activeOnly },
+  });
+}
+
+/**
+ * Create a survey (admin only)
+ */
+export async function createSurvey(request: CreateSurveyRequest): Promise<Survey> {
+  return apiClient.post<Survey>('/surveys', request);
+}
+
+/**
+ * Submit a response to a survey
+ */
+export async function submitSurveyResponse(
+  surveyId: string,
+  submission: SurveyResponseSubmission
+): Promise<void> {
+  return apiClient.post<void>(`/surveys/${surveyId}/responses`, submission);
+}
+
+/**
+ * Fetch aggregated results for a survey (admin only)
+ */
+export async function fetchSurveyResults(surveyId: string): Promise<SurveyResults> {
+  return apiClient.get<SurveyResults>(`/surveys/${surveyId}/results`);
+}
+
+/**
+ * Close a survey to new responses (admin only)
+ */
+export async function closeSurvey(surveyId: string): Promise<Survey> {
+  return apiClient.post<Survey>(`/surveys/${surveyId}/close`);
+}
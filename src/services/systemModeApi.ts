@@ -0,0 +1,57 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * Operating mode of the backend API
+ * - normal: all requests allowed
+ * - read_only: writes are rejected with 503 + explanatory payload
+ * - maintenance: all requests except health checks are rejected
+ */
+export type SystemMode = 'normal' | 'read_only' | 'maintenance';
+
+/**
+ * A scheduled mode window honored by the backend scheduler
+ */
+export interface SystemModeWindow {
+  mode: SystemMode;
+  starts_at: string; // ISO 8601
+  ends_at: string; // ISO 8601
+  reason?: string;
+}
+
+/**
+ * Current system mode from GET /admin/system-mode
+ */
+export interface SystemModeResponse {
+  mode: SystemMode;
+  reason?: string;
+  /** When the current non-normal mode is scheduled to end, if known */
+  until?: string;
+  scheduled_windows: SystemModeWindow[];
+}
+
+export interface UpdateSystemModeRequest {
+  mode: SystemMode;
+  reason?: string;
+  until?: string;
+}
+
+/**
+ * Fetch the current system mode (admin only)
+ */
+export async function fetchSystemMode(): Promise<SystemModeResponse> {
+  return apiClient.get<SystemModeResponse>('/admin/system-mode');
+}
+
+/**
+ * Switch the system mode (admin only)
+ */
+export async function updateSystemMode(request: UpdateSystemModeRequest): Promise<SystemModeResponse> {
+  return apiClient.put<SystemModeResponse>('/admin/system-mode', request);
+}
+
+/**
+ * Schedule a future mode window (admin only)
+ */
+export async function scheduleSystemModeWindow(window: SystemModeWindow): Promise<SystemModeResponse> {
+  return apiClient.post<SystemModeResponse>('/admin/system-mode/windows', window);
+}
@@ -0,0 +1,70 @@
+import { apiClient } from './ApiClient';
+
+export type TeamSyncDirection = 'portal_to_github' | 'github_to_portal' | 'bidirectional';
+
+/**
+ * Mapping between a portal team and a GitHub org team for one provider
+ */
+export interface TeamSyncMapping {
+  team_id: string;
+  team_name: string;
+  provider: string;
+  github_org: string;
+  github_team_slug: string;
+  direction: TeamSyncDirection;
+  last_synced_at?: string;
+  drift_count: number;
+}
+
+export interface TeamSyncMappingsResponse {
+  mappings: TeamSyncMapping[];
+}
+
+export interface UpsertTeamSyncMappingRequest {
+  team_id: string;
+  github_org: string;
+  github_team_slug: string;
+  direction: TeamSyncDirection;
+}
+
+/**
+ * Result of a sync run for a single mapping
+ */
+export interface TeamSyncResult {
+  team_id: string;
+  added: string[];
+  removed: string[];
+  errors: string[];
+  synced_at: string;
+}
+
+/**
+ * Fetch team-to-GitHub-team mappings for a provider
+ */
+export async function fetchTeamSyncMappings(provider: string): Promise<TeamSyncMappingsResponse> {
+  return apiClient.get<TeamSyncMappingsResponse>(`/github/${provider}/team-sync`);
+}
+
+/**
+ * Create or update a mapping between a portal team and a GitHub org team
+ */
+export async function upsertTeamSyncMapping(
+  provider: string,
+  request: UpsertTeamSyncMappingRequest
+): Promise<TeamSyncMapping> {
+  return apiClient.put<TeamSyncMapping>(`/github/${provider}/team-sync/${request.team_id}`, request);
+}
+
+/**
+ * Trigger an on-demand membership reconciliation for a mapped team
+ */
+export async function triggerTeamSync(provider: string, teamId: string): Promise<TeamSyncResult> {
+  return apiClient.post<TeamSyncResult>(`/github/${provider}/team-sync/${teamId}/run`);
+}
+
+/**
+ * Remove a team sync mapping
+ */
+export async function deleteTeamSyncMapping(provider: string, teamId: string): Promise<void> {
+  return apiClient.delete<void>(`/github/${provider}/team-sync/${teamId}`);
+}
@@ -0,0 +1,99 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * Tech radar ring (e.g., 'adopt', 'trial', 'assess', 'hold')
+ */
+export interface TechRadarRing {
+  id: string;
+  name: string;
+  color: string;
+  sort_order: number;
+}
+
+/**
+ * Tech radar quadrant (e.g., 'languages', 'platforms', 'tools', 'techniques')
+ */
+export interface TechRadarQuadrant {
+  id: string;
+  name: string;
+  sort_order: number;
+}
+
+/**
+ * A past ring placement of an entry, newest first
+ */
+export interface TechRadarEntryHistory {
+  ring_id: string;
+  moved_at: string;
+  note?: string;
+}
+
+export interface TechRadarEntry {
+  id: string;
+  name: string;
+  description?: string;
+  ring_id: string;
+  quadrant_id: string;
+  /** True when the entry moved rings since the last radar publication */
+  moved: boolean;
+  history: TechRadarEntryHistory[];
+}
+
+export interface TechRadarResponse {
+  rings: TechRadarRing[];
+  quadrants: TechRadarQuadrant[];
+  entries: TechRadarEntry[];
+  updated_at: string;
+}
+
+export interface UpsertTechRadarEntryRequest {
+  name: string;
+  description?: string;
+  ring_id: string;
+  quadrant_id: string;
+  note?: string;
+}
+
+/**
+ * Fetch the published tech radar
+ */
+export async function fetchTechRadar(): Promise<TechRadarResponse> {
+  return apiClient.get<TechRadarResponse>('/tech-radar');
+}
+
+/**
+ * Create a tech radar entry (admin only)
+ */
+export async function createTechRadarEntry(request: UpsertTechRadarEntryRequest): Promise<TechRadarEntry> {
+  return apiClient.post<TechRadarEntry>('/tech-radar/entries', request);
+}
+
+/**
+ * Update a tech radar entry, recording ring moves in its history (admin only)
+ */
+export async function updateTechRadarEntry(
+  entryId: string,
+  request: UpsertTechRadarEntryRequest
+): Promise<TechRadarEntry> {
+  return apiClient.put<TechRadarEntry>(`/tech-radar/entries/${entryId}`, request);
+}
+
+/**
+ * Delete a tech radar entry (admin only)
+ */
+export async function deleteTechRadarEntry(entryId: string): Promise<void> {
+  return apiClient.delete<void>(`/tech-radar/entries/${entryId}`);
+}
+
+/**
+ * Import radar entries from a YAML file in a GitHub repo (admin only)
+ */
+export async function importTechRadarFromRepo(params: {
+  provider: string;
+  owner: string;
+  repo: string;
+  path: string;
+  ref?: string;
+}): Promise<TechRadarResponse> {
+  return apiClient.post<TechRadarResponse>('/tech-radar/import', params);
+}
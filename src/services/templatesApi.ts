@@ -0,0 +1,56 @@
+import { apiClient } from './ApiClient';
+
+/**
+ * A GitHub template repository registered for scaffolding
+ */
+export interface TemplateRepository {
+  id: string;
+  provider: string;
+  owner: string;
+  repo: string;
+  title: string;
+  description?: string;
+  category: string; // e.g., 'service', 'library', 'frontend'
+  /** Rendered README markdown for the template preview step */
+  readme_preview?: string;
+  default_branch: string;
+}
+
+export interface TemplateRepositoriesResponse {
+  templates: TemplateRepository[];
+  categories: string[];
+}
+
+export interface CreateTemplateRepositoryRequest {
+  provider: string;
+  owner: string;
+  repo: string;
+  title: string;
+  description?: string;
+  category: string;
+}
+
+/**
+ * Fetch the org-wide template repository configuration
+ *
+ * @param category - Optional filter by template category
+ */
+export async function fetchTemplateRepositories(category?: string): Promise<TemplateRepositoriesResponse> {
+  return apiClient.get<TemplateRepositoriesResponse>('/templates', {
+    params: category ? { category } : undefined,
+  });
+}
+
+/**
+ * Register a template repository (admin only)
+ */
+export async function createTemplateRepository(request: CreateTemplateRepositoryRequest): Promise<TemplateRepository> {
+  return apiClient.post<TemplateRepository>('/templates', request);
+}
+
+/**
+ * Remove a template repository from the configuration (admin only)
+ */
+export async function deleteTemplateRepository(templateId: string): Promise<void> {
+  return apiClient.delete<void>(`/templates/${templateId}`);
+}
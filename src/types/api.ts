@@ -650,6 +650,8 @@ export interface PaginatedResponse<T> {
   page: number;
   page_size: number;
   total: number;
+  next?: string; // Link to next page, absent on the last page
+  prev?: string; // Link to previous page, absent on the first page
 }
 
 /**
@@ -801,6 +803,20 @@ export interface ApiError {
   message?: string;
   status?: number;
   details?: Record<string, unknown>;
+  /** Field-level validation errors keyed by request field name (422 responses) */
+  fieldErrors?: Record<string, string>;
+  /**
+   * Machine-readable error category from the backend error taxonomy
+   * (transient, rate_limited, auth_expired, not_found, forbidden, validation)
+   */
+  code?: string;
+  /** Seconds to wait before retrying; set for transient/rate-limited errors */
+  retryAfter?: number;
+  /**
+   * Upstream request ID (X-GitHub-Request-Id, AI Core trace id) for quoting
+   * in support tickets to the provider team
+   */
+  upstreamRequestId?: string;
 }
 
 /**
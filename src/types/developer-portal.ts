@@ -320,6 +320,8 @@ export interface GitHubPRQueryParams {
   direction?: 'asc' | 'desc';
   per_page?: number;
   page?: number;
+  /** Comma-separated field list for partial responses (e.g., 'number,title,state') */
+  fields?: string;
 }
 
 export interface LandscapeToolUrls {
@@ -368,3 +370,41 @@ export interface ClosePullRequestParams {
   repo: string;
   delete_branch: boolean;
 }
+
+export type MergeStrategy = 'merge' | 'squash' | 'rebase';
+
+export interface MergePullRequestParams {
+  prNumber: number;
+  owner: string;
+  repo: string;
+  merge_method: MergeStrategy;
+  delete_branch: boolean;
+  commit_title?: string;
+  commit_message?: string;
+}
+
+export interface MergePullRequestResponse {
+  success: boolean;
+  message: string;
+  pr_number: number;
+  sha?: string;
+  branch_deleted?: boolean;
+}
+
+export interface SummarizePullRequestParams {
+  provider: string;
+  owner: string;
+  repo: string;
+  prNumber: number;
+  model?: string;
+}
+
+/**
+ * AI-generated PR summary, structured by the backend from the diff and files
+ */
+export interface PullRequestSummaryResponse {
+  purpose: string;
+  risk: string;
+  test_coverage: string;
+  model: string;
+}
@@ -11,6 +11,12 @@ import { tokenManager } from '@/lib/tokenManager';
 // Backend URL from runtime environment configuration
 const BACKEND_URL = getNewBackendUrl();
 const ANALYTICS_ENDPOINT = `${BACKEND_URL}/api/v1/analytics/track`;
+const ANALYTICS_BATCH_ENDPOINT = `${BACKEND_URL}/api/v1/analytics/events`;
+
+// Batched events are flushed when the queue fills or the interval elapses,
+// whichever comes first
+const BATCH_MAX_SIZE = 25;
+const BATCH_FLUSH_INTERVAL_MS = 10_000;
 
 /**
  * Analytics payload structure matching backend expectations
@@ -93,9 +99,140 @@ const sendAnalytics = async (payload: AnalyticsPayload): Promise<void> => {
   }
 };
 
+/**
+ * A usage event queued for batched ingestion
+ */
+interface UsageEvent {
+  name: string;
+  url: string;
+  occurred_at: string; // ISO 8601, set at enqueue time
+  data?: Record<string, unknown>;
+}
+
+let eventQueue: UsageEvent[] = [];
+let flushTimer: ReturnType<typeof setTimeout> | null = null;
+// True while a 503 backoff timer is pending; size-triggered flushes are
+// suppressed so a refilled queue cannot re-hammer a saturated backend
+let backoffPending = false;
+
+/**
+ * Flush the queued usage events to the batched ingestion endpoint.
+ * Uses sendBeacon on page unload so the final batch survives navigation.
+ */
+const flushEventQueue = async (useBeacon = false): Promise<void> => {
+  if (eventQueue.length === 0) {
+    return;
+  }
+
+  const batch = eventQueue;
+  eventQueue = [];
+  backoffPending = false;
+
+  if (flushTimer) {
+    clearTimeout(flushTimer);
+    flushTimer = null;
+  }
+
+  const token = tokenManager.getToken();
+  if (!token) {
+    console.debug('Analytics: No auth token available - dropping event batch');
+    return;
+  }
+
+  if (useBeacon && navigator.sendBeacon) {
+    // sendBeacon cannot carry an Authorization header, so the batch endpoint
+    // also accepts the token inside the payload for unload-time flushes
+    const beaconBody = JSON.stringify({ events: batch, access_token: token });
+    navigator.sendBeacon(
+      ANALYTICS_BATCH_ENDPOINT,
+      new Blob([beaconBody], { type: 'application/json' })
+    );
+    return;
+  }
+
+  const body = JSON.stringify({ events: batch });
+
+  try {
+    const response = await fetch(ANALYTICS_BATCH_ENDPOINT, {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+        'Authorization': `Bearer ${token}`,
+      },
+      body,
+      credentials: 'include',
+    });
+
+    if (response.status === 503) {
+      // The backend sheds analytics first under saturation; re-queue the
+      // batch (bounded) and back off instead of adding to the load
+      eventQueue = [...batch.slice(-BATCH_MAX_SIZE), ...eventQueue].slice(0, BATCH_MAX_SIZE * 2);
+      backoffPending = true;
+      flushTimer = setTimeout(() => {
+        flushTimer = null;
+        flushEventQueue();
+      }, BATCH_FLUSH_INTERVAL_MS * 3);
+      return;
+    }
+
+    if (!response.ok) {
+      console.debug('Analytics batch ingestion failed:', response.status);
+    }
+  } catch (error) {
+    // Fail silently - analytics failures should never break the app
+    console.debug('Analytics batch ingestion error:', error);
+  }
+};
+
+if (typeof window !== 'undefined') {
+  window.addEventListener('pagehide', () => {
+    flushEventQueue(true);
+  });
+}
+
+/**
+ * Queue a usage event for batched ingestion via POST /analytics/events.
+ * Prefer this over trackEvent for high-frequency signals (page views,
+ * feature usage) so the backend can sample and forward them in bulk.
+ *
+ * @param eventName - Name of the event to track
+ * @param eventData - Optional additional data to include with the event
+ *
+ * @example
+ * queueUsageEvent('docs_page_viewed', { repo: 'cfs-docs' });
+ */
+export const queueUsageEvent = (
+  eventName: string,
+  eventData: Record<string, unknown> = {}
+): void => {
+  if (!isAnalyticsEnabled()) {
+    return;
+  }
+
+  eventQueue.push({
+    name: eventName,
+    url: window.location.pathname,
+    occurred_at: new Date().toISOString(),
+    data: eventData,
+  });
+
+  if (eventQueue.length >= BATCH_MAX_SIZE) {
+    // While backing off after a 503, hold the full queue for the retry
+    // timer instead of flushing immediately into the saturated backend
+    if (!backoffPending) {
+      flushEventQueue();
+    }
+    return;
+  }
+
+  if (!flushTimer) {
+    flushTimer = setTimeout(() => flushEventQueue(), BATCH_FLUSH_INTERVAL_MS);
+  }
+};
+
 /**
  * Track a page view
- * 
+ *
  * @param url - Page URL to track (defaults to current pathname)
  * 
  * @example
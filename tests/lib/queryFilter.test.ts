@@ -0,0 +1,80 @@
+import { describe, it, expect } from 'vitest';
+import {
+  serializeFilter,
+  buildFilterParams,
+  type QueryFilter,
+  type QuerySort,
+} from '../../src/lib/queryFilter';
+
+describe('queryFilter', () => {
+  describe('serializeFilter', () => {
+    it('should serialize a string filter into field:op:value form', () => {
+      const filter: QueryFilter = { field: 'name', op: 'like', value: 'auth' };
+
+      expect(serializeFilter(filter)).toBe('name:like:auth');
+    });
+
+    it('should serialize number and boolean values', () => {
+      expect(serializeFilter({ field: 'count', op: 'gt', value: 5 })).toBe('count:gt:5');
+      expect(serializeFilter({ field: 'favorite', op: 'eq', value: true })).toBe(
+        'favorite:eq:true'
+      );
+    });
+
+    it('should serialize array values for the in operator joined with pipes', () => {
+      const filter: QueryFilter = { field: 'status', op: 'in', value: ['active', 'archived'] };
+
+      expect(serializeFilter(filter)).toBe('status:in:active|archived');
+    });
+
+    it('should percent-encode delimiter characters in values', () => {
+      const filter: QueryFilter = { field: 'name', op: 'like', value: 'foo,bar' };
+
+      expect(serializeFilter(filter)).toBe('name:like:foo%2Cbar');
+    });
+
+    it('should percent-encode colons and pipes in values', () => {
+      expect(serializeFilter({ field: 'url', op: 'eq', value: 'https://x' })).toBe(
+        'url:eq:https%3A%2F%2Fx'
+      );
+      expect(serializeFilter({ field: 'name', op: 'eq', value: 'a|b' })).toBe('name:eq:a%7Cb');
+    });
+
+    it('should percent-encode delimiters inside array entries', () => {
+      const filter: QueryFilter = { field: 'tags', op: 'in', value: ['a|b', 'c,d'] };
+
+      expect(serializeFilter(filter)).toBe('tags:in:a%7Cb|c%2Cd');
+    });
+  });
+
+  describe('buildFilterParams', () => {
+    it('should join multiple filters with commas', () => {
+      const filters: QueryFilter[] = [
+        { field: 'name', op: 'like', value: 'auth' },
+        { field: 'created_at', op: 'gt', value: '2026-01-01' },
+      ];
+
+      expect(buildFilterParams(filters)).toEqual({
+        filter: 'name:like:auth,created_at:gt:2026-01-01',
+        sort: undefined,
+      });
+    });
+
+    it('should serialize sorts with a - prefix for descending', () => {
+      const sorts: QuerySort[] = [
+        { field: 'created_at', direction: 'desc' },
+        { field: 'name' },
+      ];
+
+      expect(buildFilterParams(undefined, sorts)).toEqual({
+        filter: undefined,
+        sort: '-created_at,name',
+      });
+    });
+
+    it('should return undefined params for empty input', () => {
+      expect(buildFilterParams()).toEqual({ filter: undefined, sort: undefined });
+      expect(buildFilterParams([], [])).toEqual({ filter: undefined, sort: undefined });
+    });
+  });
+});
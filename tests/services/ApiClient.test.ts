@@ -553,6 +553,136 @@ describe('ApiClient', () => {
       await expect(client.get('/teams')).rejects.toThrow('Internal Server Error');
     });
 
+    it('should surface the machine-readable error code and retry_after from the envelope', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 429,
+        statusText: 'Too Many Requests',
+        headers: { get: () => null },
+        json: async () => ({
+          message: 'Rate limit exceeded',
+          code: 'rate_limited',
+          retry_after: 30,
+        }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/teams').catch((error) => { caught = error; });
+
+      expect(caught.apiError.code).toBe('rate_limited');
+      expect(caught.apiError.retryAfter).toBe(30);
+    });
+
+    it('should fall back to the Retry-After header when the envelope omits retry_after', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 503,
+        statusText: 'Service Unavailable',
+        headers: { get: (name: string) => (name === 'Retry-After' ? '120' : null) },
+        json: async () => ({
+          message: 'Service temporarily unavailable',
+          code: 'upstream_unavailable',
+        }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/teams').catch((error) => { caught = error; });
+
+      expect(caught.apiError.code).toBe('upstream_unavailable');
+      expect(caught.apiError.retryAfter).toBe(120);
+    });
+
+    it('should leave code and retryAfter unset for plain error envelopes', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 500,
+        statusText: 'Internal Server Error',
+        headers: { get: () => null },
+        json: async () => ({ message: 'Something went wrong' }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/teams').catch((error) => { caught = error; });
+
+      expect(caught.apiError.code).toBeUndefined();
+      expect(caught.apiError.retryAfter).toBeUndefined();
+    });
+
+    it('should surface field-level validation errors from the envelope', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 400,
+        statusText: 'Bad Request',
+        headers: { get: () => null },
+        json: async () => ({
+          message: 'Validation failed',
+          errors: {
+            per_page: 'must be between 1 and 100',
+            name: 'is required',
+          },
+        }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/teams').catch((error) => { caught = error; });
+
+      expect(caught.message).toBe('Validation failed');
+      expect(caught.apiError.fieldErrors).toEqual({
+        per_page: 'must be between 1 and 100',
+        name: 'is required',
+      });
+    });
+
+    it('should not set fieldErrors when the envelope has no errors object', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 400,
+        statusText: 'Bad Request',
+        headers: { get: () => null },
+        json: async () => ({ message: 'Invalid request data' }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/teams').catch((error) => { caught = error; });
+
+      expect(caught.apiError.fieldErrors).toBeUndefined();
+    });
+
+    it('should surface the upstream request id from the envelope', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 502,
+        statusText: 'Bad Gateway',
+        headers: { get: () => null },
+        json: async () => ({
+          message: 'GitHub request failed',
+          upstream_request_id: 'GH-1234:5678',
+        }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/github/contributions').catch((error) => { caught = error; });
+
+      expect(caught.apiError.upstreamRequestId).toBe('GH-1234:5678');
+    });
+
+    it('should fall back to the X-Upstream-Request-Id header', async () => {
+      (global.fetch as any).mockResolvedValueOnce({
+        ok: false,
+        status: 504,
+        statusText: 'Gateway Timeout',
+        headers: {
+          get: (name: string) => (name === 'X-Upstream-Request-Id' ? 'AICORE-trace-42' : null),
+        },
+        json: async () => ({ message: 'AI Core request timed out' }),
+      } as unknown as Response);
+
+      let caught: any;
+      await client.get('/ai-core/deployments').catch((error) => { caught = error; });
+
+      expect(caught.apiError.upstreamRequestId).toBe('AICORE-trace-42');
+    });
+
     it('should handle 204 No Content response', async () => {
       (global.fetch as any).mockResolvedValueOnce({
         ok: true,
@@ -627,6 +757,81 @@ describe('ApiClient', () => {
       );
     });
 
+    it('should echo the CSRF cookie on mutating requests', async () => {
+      document.cookie = 'XSRF-TOKEN=csrf-token-123';
+      const mockExpirationTime = Math.floor(Date.now() / 1000) + 3600; // 1 hour from now
+      global.fetch = vi.fn()
+        .mockResolvedValueOnce({
+          ok: true,
+          json: async () => ({
+            accessToken: 'test-token',
+            expirationTime: mockExpirationTime
+          }),
+        } as Response)
+        .mockResolvedValueOnce({
+          ok: true,
+          json: async () => ({ id: '123' }),
+        } as Response);
+
+      await client.post('/teams', { name: 'new-team' });
+
+      expect(fetch).toHaveBeenNthCalledWith(2,
+        expect.any(String),
+        expect.objectContaining({
+          headers: expect.objectContaining({
+            'X-CSRF-Token': 'csrf-token-123'
+          })
+        })
+      );
+
+      document.cookie = 'XSRF-TOKEN=; expires=Thu, 01 Jan 1970 00:00:00 GMT';
+    });
+
+    it('should not send the CSRF header on GET requests', async () => {
+      document.cookie = 'XSRF-TOKEN=csrf-token-123';
+      const mockExpirationTime = Math.floor(Date.now() / 1000) + 3600; // 1 hour from now
+      global.fetch = vi.fn()
+        .mockResolvedValueOnce({
+          ok: true,
+          json: async () => ({
+            accessToken: 'test-token',
+            expirationTime: mockExpirationTime
+          }),
+        } as Response)
+        .mockResolvedValueOnce({
+          ok: true,
+          json: async () => ({ data: [] }),
+        } as Response);
+
+      await client.get('/teams');
+
+      const [, fetchOptions] = (fetch as any).mock.calls[1];
+      expect(fetchOptions.headers).not.toHaveProperty('X-CSRF-Token');
+
+      document.cookie = 'XSRF-TOKEN=; expires=Thu, 01 Jan 1970 00:00:00 GMT';
+    });
+
+    it('should omit the CSRF header when no cookie is present', async () => {
+      const mockExpirationTime = Math.floor(Date.now() / 1000) + 3600; // 1 hour from now
+      global.fetch = vi.fn()
+        .mockResolvedValueOnce({
+          ok: true,
+          json: async () => ({
+            accessToken: 'test-token',
+            expirationTime: mockExpirationTime
+          }),
+        } as Response)
+        .mockResolvedValueOnce({
+          ok: true,
+          json: async () => ({ id: '123' }),
+        } as Response);
+
+      await client.post('/teams', { name: 'new-team' });
+
+      const [, fetchOptions] = (fetch as any).mock.calls[1];
+      expect(fetchOptions.headers).not.toHaveProperty('X-CSRF-Token');
+    });
+
     it('should include credentials in all requests', async () => {
       const mockExpirationTime = Math.floor(Date.now() / 1000) + 3600; // 1 hour from now
       global.fetch = vi.fn()
@@ -16,6 +16,7 @@ import {
   deleteGitHubFile,
   deleteGitHubFolder,
   isFolderEmpty,
+  askDocsQuestion,
   DOCS_CONFIG,
   type GitHubContent,
   type DocTreeNode,
@@ -23,10 +24,11 @@ import {
 import { ApiClient } from '@/services/ApiClient';
 
 // Create mock functions using vi.hoisted() so they're available during mock hoisting
-const { mockGet, mockPost, mockDelete } = vi.hoisted(() => ({
+const { mockGet, mockPost, mockDelete, mockGetToken } = vi.hoisted(() => ({
   mockGet: vi.fn(),
   mockPost: vi.fn(),
   mockDelete: vi.fn(),
+  mockGetToken: vi.fn(),
 }));
 
 // Mock ApiClient
@@ -36,6 +38,7 @@ vi.mock('@/services/ApiClient', () => {
       get: mockGet,
       post: mockPost,
       delete: mockDelete,
+      getToken: mockGetToken,
     })),
   };
 });
@@ -50,6 +53,7 @@ describe('githubDocsApi', () => {
     mockGet.mockClear();
     mockPost.mockClear();
     mockDelete.mockClear();
+    mockGetToken.mockClear();
   });
 
   afterEach(() => {
@@ -1099,6 +1103,128 @@ describe('githubDocsApi', () => {
     });
   });
 
+  describe('askDocsQuestion', () => {
+    let originalFetch: typeof global.fetch;
+
+    // Build a mock streaming response from a list of SSE lines
+    const mockSseResponse = (lines: string[]) => {
+      const read = vi.fn();
+      for (const line of lines) {
+        read.mockResolvedValueOnce({
+          done: false,
+          value: new TextEncoder().encode(`${line}\n\n`),
+        });
+      }
+      read.mockResolvedValueOnce({ done: true, value: undefined });
+
+      return {
+        ok: true,
+        body: {
+          getReader: () => ({
+            read,
+            releaseLock: vi.fn(),
+          }),
+        },
+      };
+    };
+
+    beforeEach(() => {
+      originalFetch = global.fetch;
+      mockGetToken.mockResolvedValue('test-token');
+    });
+
+    afterEach(() => {
+      global.fetch = originalFetch;
+    });
+
+    it('should POST the question to the repo-scoped ask endpoint', async () => {
+      global.fetch = vi.fn().mockResolvedValue(mockSseResponse(['data: [DONE]']) as any);
+
+      await askDocsQuestion('How do I deploy?');
+
+      expect(global.fetch).toHaveBeenCalledWith(
+        'http://localhost:7008/api/v1/github/githubtools/repos/cfs-platform-engineering/cfs-platform-docs/ask',
+        expect.objectContaining({
+          method: 'POST',
+          headers: expect.objectContaining({
+            'Authorization': 'Bearer test-token',
+          }),
+          body: JSON.stringify({ question: 'How do I deploy?' }),
+        })
+      );
+    });
+
+    it('should accumulate answer deltas and report them via onChunk', async () => {
+      global.fetch = vi.fn().mockResolvedValue(
+        mockSseResponse([
+          'data: {"delta":"Hello"}',
+          'data: {"delta":" world"}',
+          'data: [DONE]',
+        ]) as any
+      );
+
+      const onChunk = vi.fn();
+      const result = await askDocsQuestion('question', onChunk);
+
+      expect(result.answer).toBe('Hello world');
+      expect(onChunk).toHaveBeenNthCalledWith(1, 'Hello');
+      expect(onChunk).toHaveBeenNthCalledWith(2, 'Hello world');
+    });
+
+    it('should collect citations from the final event', async () => {
+      global.fetch = vi.fn().mockResolvedValue(
+        mockSseResponse([
+          'data: {"delta":"See the deploy guide."}',
+          'data: {"citations":[{"path":"docs/coe/deploy.md","excerpt":"Deploying"}]}',
+          'data: [DONE]',
+        ]) as any
+      );
+
+      const result = await askDocsQuestion('question');
+
+      expect(result).toEqual({
+        answer: 'See the deploy guide.',
+        citations: [{ path: 'docs/coe/deploy.md', excerpt: 'Deploying' }],
+      });
+    });
+
+    it('should ignore malformed SSE data and keep reading', async () => {
+      const consoleWarnSpy = vi.spyOn(console, 'warn').mockImplementation(() => {});
+      global.fetch = vi.fn().mockResolvedValue(
+        mockSseResponse([
+          'data: {not json',
+          'data: {"delta":"ok"}',
+          'data: [DONE]',
+        ]) as any
+      );
+
+      const result = await askDocsQuestion('question');
+
+      expect(result.answer).toBe('ok');
+      expect(consoleWarnSpy).toHaveBeenCalled();
+
+      consoleWarnSpy.mockRestore();
+    });
+
+    it('should throw on a non-ok response', async () => {
+      global.fetch = vi.fn().mockResolvedValue({
+        ok: false,
+        json: async () => ({ message: 'Repo has no RAG index' }),
+      } as any);
+
+      await expect(askDocsQuestion('question')).rejects.toThrow('Repo has no RAG index');
+    });
+
+    it('should throw when the response body is null', async () => {
+      global.fetch = vi.fn().mockResolvedValue({
+        ok: true,
+        body: null,
+      } as any);
+
+      await expect(askDocsQuestion('question')).rejects.toThrow('Response body is null');
+    });
+  });
+
   describe('DOCS_CONFIG', () => {
     it('should have correct default configuration with provider', () => {
       // UPDATED: Expect provider field
@@ -0,0 +1,169 @@
+import { describe, it, expect, vi, beforeEach, afterEach } from 'vitest';
+
+// Mock the token manager so batched flushes see a stable token
+const { mockGetToken } = vi.hoisted(() => ({
+  mockGetToken: vi.fn(),
+}));
+
+vi.mock('@/lib/tokenManager', () => ({
+  tokenManager: {
+    getToken: mockGetToken,
+  },
+}));
+
+describe('analytics batching', () => {
+  let analytics: typeof import('../../src/utils/analytics');
+  let originalFetch: typeof global.fetch;
+
+  beforeEach(async () => {
+    originalFetch = global.fetch;
+
+    // Analytics is disabled in the test environment by default; re-enable it
+    // so the queue under test actually accepts events
+    vi.stubEnv('MODE', 'development');
+    vi.stubEnv('NODE_ENV', 'development');
+
+    vi.useFakeTimers();
+    mockGetToken.mockReturnValue('test-token');
+    global.fetch = vi.fn().mockResolvedValue({ ok: true, status: 200 } as Response);
+    vi.spyOn(console, 'debug').mockImplementation(() => {});
+
+    // Fresh module per test so the queue and timers start empty
+    vi.resetModules();
+    analytics = await import('../../src/utils/analytics');
+  });
+
+  afterEach(() => {
+    global.fetch = originalFetch;
+    vi.unstubAllEnvs();
+    vi.useRealTimers();
+    vi.restoreAllMocks();
+  });
+
+  const queuedEvents = (call: any): any[] => JSON.parse(call[1].body).events;
+
+  it('should flush the queue once it reaches the batch size', async () => {
+    for (let i = 0; i < 25; i++) {
+      analytics.queueUsageEvent('docs_page_viewed', { index: i });
+    }
+    await vi.runOnlyPendingTimersAsync();
+
+    const batchCalls = (global.fetch as any).mock.calls.filter((call: any) =>
+      call[0].includes('/analytics/events')
+    );
+    expect(batchCalls).toHaveLength(1);
+    expect(queuedEvents(batchCalls[0])).toHaveLength(25);
+  });
+
+  it('should flush a partial batch after the flush interval', async () => {
+    analytics.queueUsageEvent('docs_page_viewed');
+
+    expect(global.fetch).not.toHaveBeenCalled();
+
+    await vi.advanceTimersByTimeAsync(10_000);
+
+    const batchCalls = (global.fetch as any).mock.calls.filter((call: any) =>
+      call[0].includes('/analytics/events')
+    );
+    expect(batchCalls).toHaveLength(1);
+    expect(queuedEvents(batchCalls[0])).toHaveLength(1);
+    expect(queuedEvents(batchCalls[0])[0].name).toBe('docs_page_viewed');
+  });
+
+  it('should send the authenticated batch with a Bearer token', async () => {
+    analytics.queueUsageEvent('docs_page_viewed');
+    await vi.advanceTimersByTimeAsync(10_000);
+
+    expect(global.fetch).toHaveBeenCalledWith(
+      expect.stringContaining('/analytics/events'),
+      expect.objectContaining({
+        method: 'POST',
+        headers: expect.objectContaining({
+          'Authorization': 'Bearer test-token',
+        }),
+      })
+    );
+  });
+
+  it('should flush via sendBeacon with the token in the payload on pagehide', async () => {
+    const sendBeacon = vi.fn().mockReturnValue(true);
+    Object.defineProperty(navigator, 'sendBeacon', {
+      value: sendBeacon,
+      configurable: true,
+      writable: true,
+    });
+
+    analytics.queueUsageEvent('docs_page_viewed');
+    window.dispatchEvent(new Event('pagehide'));
+
+    expect(sendBeacon).toHaveBeenCalledWith(
+      expect.stringContaining('/analytics/events'),
+      expect.any(Blob)
+    );
+
+    const blob: Blob = sendBeacon.mock.calls[0][1];
+    const payload = JSON.parse(await blob.text());
+    expect(payload.access_token).toBe('test-token');
+    expect(payload.events).toHaveLength(1);
+    expect(global.fetch).not.toHaveBeenCalled();
+  });
+
+  it('should re-queue a shed batch on 503 and retry after the backoff', async () => {
+    (global.fetch as any)
+      .mockResolvedValueOnce({ ok: false, status: 503 } as Response)
+      .mockResolvedValue({ ok: true, status: 200 } as Response);
+
+    for (let i = 0; i < 25; i++) {
+      analytics.queueUsageEvent('docs_page_viewed', { index: i });
+    }
+    await vi.advanceTimersByTimeAsync(0);
+
+    expect(global.fetch).toHaveBeenCalledTimes(1);
+
+    // Backoff is 3x the flush interval
+    await vi.advanceTimersByTimeAsync(30_000);
+
+    expect(global.fetch).toHaveBeenCalledTimes(2);
+    expect(queuedEvents((global.fetch as any).mock.calls[1])).toHaveLength(25);
+  });
+
+  it('should suppress size-triggered flushes while backing off after a 503', async () => {
+    (global.fetch as any)
+      .mockResolvedValueOnce({ ok: false, status: 503 } as Response)
+      .mockResolvedValue({ ok: true, status: 200 } as Response);
+
+    for (let i = 0; i < 25; i++) {
+      analytics.queueUsageEvent('docs_page_viewed', { index: i });
+    }
+    await vi.advanceTimersByTimeAsync(0);
+    expect(global.fetch).toHaveBeenCalledTimes(1);
+
+    // The re-queued batch puts the queue back at the size threshold; the
+    // next event must not trigger an immediate flush into the backoff
+    analytics.queueUsageEvent('docs_page_viewed', { index: 25 });
+    await vi.advanceTimersByTimeAsync(0);
+
+    expect(global.fetch).toHaveBeenCalledTimes(1);
+
+    // The retry timer delivers the held events instead
+    await vi.advanceTimersByTimeAsync(30_000);
+
+    expect(global.fetch).toHaveBeenCalledTimes(2);
+    expect(queuedEvents((global.fetch as any).mock.calls[1])).toHaveLength(26);
+  });
+
+  it('should drop the unload batch instead of sending it unauthenticated', () => {
+    mockGetToken.mockReturnValue(null);
+    const sendBeacon = vi.fn().mockReturnValue(true);
+    Object.defineProperty(navigator, 'sendBeacon', {
+      value: sendBeacon,
+      configurable: true,
+      writable: true,
+    });
+
+    analytics.queueUsageEvent('docs_page_viewed');
+    window.dispatchEvent(new Event('pagehide'));
+
+    expect(sendBeacon).not.toHaveBeenCalled();
+  });
+});